@@ -44,11 +44,13 @@ import (
 	"github.com/aws/aws-sdk-go/service/pinpoint"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	terraformsdk "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -56,7 +58,6 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
-	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
 	tfaccount "github.com/hashicorp/terraform-provider-aws/internal/service/account"
 	tfacmpca "github.com/hashicorp/terraform-provider-aws/internal/service/acmpca"
@@ -64,6 +65,7 @@ import (
 	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
 	tforganizations "github.com/hashicorp/terraform-provider-aws/internal/service/organizations"
 	tfsts "github.com/hashicorp/terraform-provider-aws/internal/service/sts"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 	"github.com/jmespath/go-jmespath"
@@ -89,6 +91,9 @@ const (
 	// Provider name for third configuration testing
 	ProviderNameThird = "awsthird"
 
+	// Provider name for echoing ephemeral resource values in testing
+	ProviderNameEcho = "echo"
+
 	ResourcePrefix = "tf-acc-test"
 
 	CertificateIssueTimeout = 5 * time.Minute
@@ -115,6 +120,15 @@ var (
 	ProtoV5ProviderFactories map[string]func() (tfprotov5.ProviderServer, error) = protoV5ProviderFactoriesInit(context.Background(), ProviderName)
 )
 
+// ProtoV6ProviderFactoriesEcho is a static map containing only the echo provider instance.
+//
+// The echo provider surfaces ephemeral resource values in state so that they can be checked.
+var (
+	ProtoV6ProviderFactoriesEcho map[string]func() (tfprotov6.ProviderServer, error) = map[string]func() (tfprotov6.ProviderServer, error){
+		ProviderNameEcho: echoprovider.NewProviderServer(),
+	}
+)
+
 // Provider is the "main" provider instance
 //
 // This Provider can be used in testing code for API calls without requiring
@@ -1428,6 +1442,30 @@ provider "aws" {
 `, keyPrefix1)
 }
 
+func ConfigIgnoreTagsKeyRegexes1(keyRegex1 string) string {
+	//lintignore:AT004
+	return fmt.Sprintf(`
+provider "aws" {
+  ignore_tags {
+    key_regexes = [%[1]q]
+  }
+}
+`, keyRegex1)
+}
+
+func ConfigIgnoreTagsKeyValuePairs1(key1, value1 string) string {
+	//lintignore:AT004
+	return fmt.Sprintf(`
+provider "aws" {
+  ignore_tags {
+    key_value_pairs = {
+      %[1]q = %[2]q
+    }
+  }
+}
+`, key1, value1)
+}
+
 func ConfigIgnoreTagsKeys(key1 string) string {
 	//lintignore:AT004
 	return fmt.Sprintf(`
@@ -1489,7 +1527,7 @@ func RegionProviderFunc(region string, providers *[]*schema.Provider) ProviderFu
 				continue
 			}
 
-			clientRegion := client.Region
+			clientRegion := client.Region(context.Background())
 			log.Printf("[DEBUG] Checking AWS provider region %q against %q", clientRegion, region)
 			if clientRegion == region {
 				log.Printf("[DEBUG] Found AWS provider with region: %s", region)
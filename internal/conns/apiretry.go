@@ -4,6 +4,9 @@
 package conns
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/retry"
 )
@@ -27,3 +30,64 @@ func (r *withIsErrorRetryables) IsErrorRetryable(err error) bool {
 	}
 	return r.RetryerV2.IsErrorRetryable(err)
 }
+
+// AddRateLimiter returns a Retryer which deducts retry costs from the specified rate limiter in place of the wrapped Retryer's.
+// The rate limiter can be shared by multiple Retryers.
+func AddRateLimiter(r aws.RetryerV2, rateLimiter retry.RateLimiter) aws.RetryerV2 {
+	return &withRateLimiter{
+		RetryerV2:   r,
+		rateLimiter: rateLimiter,
+		timeouts:    retry.DefaultTimeouts,
+	}
+}
+
+type withRateLimiter struct {
+	aws.RetryerV2
+	rateLimiter retry.RateLimiter
+	timeouts    retry.IsErrorTimeouts
+}
+
+func (r *withRateLimiter) GetAttemptToken(ctx context.Context) (func(error) error, error) {
+	release, err := r.RetryerV2.GetAttemptToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(opErr error) error {
+		if err := release(opErr); err != nil {
+			return err
+		}
+		if opErr != nil {
+			return nil
+		}
+		return r.rateLimiter.AddTokens(retry.DefaultNoRetryIncrement)
+	}, nil
+}
+
+func (r *withRateLimiter) GetInitialToken() func(error) error {
+	return func(opErr error) error {
+		if opErr != nil {
+			return nil
+		}
+		return r.rateLimiter.AddTokens(retry.DefaultNoRetryIncrement)
+	}
+}
+
+func (r *withRateLimiter) GetRetryToken(ctx context.Context, opErr error) (func(error) error, error) {
+	cost := retry.DefaultRetryCost
+	if r.timeouts.IsErrorTimeout(opErr).Bool() {
+		cost = retry.DefaultRetryTimeoutCost
+	}
+
+	release, err := r.rateLimiter.GetToken(ctx, cost)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rate limit token, %w", err)
+	}
+
+	return func(opErr error) error {
+		if opErr != nil {
+			return nil
+		}
+		return release()
+	}, nil
+}
@@ -4,11 +4,13 @@
 package conns
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/ratelimit"
 	"github.com/aws/aws-sdk-go-v2/aws/retry"
 	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
 	appconfigtypes "github.com/aws/aws-sdk-go-v2/service/appconfig/types"
@@ -94,3 +96,36 @@ func TestAddIsErrorRetryables(t *testing.T) {
 		})
 	}
 }
+
+func TestAddRateLimiter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	rateLimiter := ratelimit.NewTokenRateLimit(10)
+	// The rate limiter is shared.
+	r1 := AddRateLimiter(retry.NewStandard(), rateLimiter)
+	r2 := AddRateLimiter(retry.NewStandard(), rateLimiter)
+	opErr := errors.New("testing")
+
+	release1, err := r1.GetRetryToken(ctx, opErr)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, err := r2.GetRetryToken(ctx, opErr); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, err := r1.GetRetryToken(ctx, opErr); !errs.IsA[ratelimit.QuotaExceededError](err) {
+		t.Fatalf("expected QuotaExceededError, got: %v", err)
+	}
+
+	// A successful retry returns its cost.
+	if err := release1(nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, err := r2.GetRetryToken(ctx, opErr); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	ststypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// assumeRoleHopSummary annotates a diagnostic summary with the position of an assume_role configuration block in a role chain.
+func assumeRoleHopSummary(summary string, hop, hops int) string {
+	if hops < 2 {
+		return summary
+	}
+
+	return fmt.Sprintf("%s (assume_role %d of %d)", summary, hop+1, hops)
+}
+
+// validateAssumeRoles checks that every IAM Role in a role chain is set.
+func (c *Config) validateAssumeRoles() diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	hops := len(c.AssumeRole)
+	if hops < 2 {
+		return diags
+	}
+
+	for hop, ar := range c.AssumeRole {
+		if ar.RoleARN == "" {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  assumeRoleHopSummary("Cannot assume IAM Role", hop, hops),
+				Detail:   "IAM Role ARN not set",
+			})
+		}
+	}
+
+	return diags
+}
+
+// chainAssumeRoles returns a credentials provider that assumes each IAM Role after the first in turn,
+// each using the credentials of the previous role.
+// The first IAM Role is assumed by the AWS SDK for Go Base.
+func (c *Config) chainAssumeRoles(ctx context.Context, cfg aws_sdkv2.Config) (aws_sdkv2.CredentialsProvider, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	credentials := cfg.Credentials
+	hops := len(c.AssumeRole)
+
+	for hop := 1; hop < hops; hop++ {
+		ar := c.AssumeRole[hop]
+
+		tflog.Info(ctx, "Assuming IAM Role", map[string]any{
+			"tf_aws.assume_role.hop":             hop + 1,
+			"tf_aws.assume_role.role_arn":        ar.RoleARN,
+			"tf_aws.assume_role.session_name":    ar.SessionName,
+			"tf_aws.assume_role.external_id":     ar.ExternalID,
+			"tf_aws.assume_role.source_identity": ar.SourceIdentity,
+		})
+
+		cfg := cfg.Copy()
+		cfg.Credentials = credentials
+		client := sts.NewFromConfig(cfg, func(o *sts.Options) {
+			if c.STSRegion != "" {
+				o.Region = c.STSRegion
+			}
+			if v := c.Endpoints[names.STS]; v != "" {
+				o.BaseEndpoint = aws_sdkv2.String(v)
+			}
+		})
+
+		provider := aws_sdkv2.NewCredentialsCache(stscreds.NewAssumeRoleProvider(client, ar.RoleARN, func(o *stscreds.AssumeRoleOptions) {
+			expandAssumeRoleOptions(o, ar)
+		}))
+
+		if _, err := provider.Retrieve(ctx); err != nil {
+			return nil, append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  assumeRoleHopSummary("Cannot assume IAM Role", hop, hops),
+				Detail: fmt.Sprintf("IAM Role (%s) cannot be assumed using the credentials of IAM Role (%s).\n\nError: %s",
+					ar.RoleARN, c.AssumeRole[hop-1].RoleARN, err),
+			})
+		}
+
+		credentials = provider
+	}
+
+	return credentials, diags
+}
+
+func expandAssumeRoleOptions(o *stscreds.AssumeRoleOptions, ar awsbase.AssumeRole) {
+	o.RoleSessionName = ar.SessionName
+	o.Duration = ar.Duration
+
+	if ar.ExternalID != "" {
+		o.ExternalID = aws_sdkv2.String(ar.ExternalID)
+	}
+
+	if ar.Policy != "" {
+		o.Policy = aws_sdkv2.String(ar.Policy)
+	}
+
+	for _, v := range ar.PolicyARNs {
+		o.PolicyARNs = append(o.PolicyARNs, ststypes.PolicyDescriptorType{
+			Arn: aws_sdkv2.String(v),
+		})
+	}
+
+	if ar.SourceIdentity != "" {
+		o.SourceIdentity = aws_sdkv2.String(ar.SourceIdentity)
+	}
+
+	for k, v := range ar.Tags {
+		o.Tags = append(o.Tags, ststypes.Tag{
+			Key:   aws_sdkv2.String(k),
+			Value: aws_sdkv2.String(v),
+		})
+	}
+
+	o.TransitiveTagKeys = ar.TransitiveTagKeys
+}
@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	ststypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+)
+
+func TestAssumeRoleHopSummary(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		hop, hops int
+		expected  string
+	}{
+		"single": {
+			hop:      0,
+			hops:     1,
+			expected: "Cannot assume IAM Role",
+		},
+		"first of three": {
+			hop:      0,
+			hops:     3,
+			expected: "Cannot assume IAM Role (assume_role 1 of 3)",
+		},
+		"last of three": {
+			hop:      2,
+			hops:     3,
+			expected: "Cannot assume IAM Role (assume_role 3 of 3)",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := assumeRoleHopSummary("Cannot assume IAM Role", testCase.hop, testCase.hops), testCase.expected; got != want {
+				t.Errorf("assumeRoleHopSummary = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestConfigValidateAssumeRoles(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		assumeRoles []awsbase.AssumeRole
+		expected    []string
+	}{
+		"none": {},
+		"single empty": {
+			assumeRoles: []awsbase.AssumeRole{{}},
+		},
+		"chain": {
+			assumeRoles: []awsbase.AssumeRole{
+				{RoleARN: "arn:aws:iam::111111111111:role/first"},  //lintignore:AWSAT005
+				{RoleARN: "arn:aws:iam::222222222222:role/second"}, //lintignore:AWSAT005
+			},
+		},
+		"chain missing role": {
+			assumeRoles: []awsbase.AssumeRole{
+				{RoleARN: "arn:aws:iam::111111111111:role/first"}, //lintignore:AWSAT005
+				{},
+			},
+			expected: []string{"Cannot assume IAM Role (assume_role 2 of 2)"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			c := &Config{
+				AssumeRole: testCase.assumeRoles,
+			}
+			diags := c.validateAssumeRoles()
+
+			var got []string
+			for _, d := range diags {
+				got = append(got, d.Summary)
+			}
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandAssumeRoleOptions(t *testing.T) {
+	t.Parallel()
+
+	ar := awsbase.AssumeRole{
+		Duration:          time.Hour,
+		ExternalID:        "external-id",
+		PolicyARNs:        []string{"arn:aws:iam::aws:policy/ReadOnlyAccess"}, //lintignore:AWSAT005
+		SessionName:       "session-name",
+		SourceIdentity:    "source-identity",
+		Tags:              map[string]string{"key": "value"},
+		TransitiveTagKeys: []string{"key"},
+	}
+	expected := stscreds.AssumeRoleOptions{
+		Duration:          time.Hour,
+		ExternalID:        aws.String("external-id"),
+		PolicyARNs:        []ststypes.PolicyDescriptorType{{Arn: aws.String("arn:aws:iam::aws:policy/ReadOnlyAccess")}}, //lintignore:AWSAT005
+		RoleSessionName:   "session-name",
+		SourceIdentity:    aws.String("source-identity"),
+		Tags:              []ststypes.Tag{{Key: aws.String("key"), Value: aws.String("value")}},
+		TransitiveTagKeys: []string{"key"},
+	}
+
+	var got stscreds.AssumeRoleOptions
+	expandAssumeRoleOptions(&got, ar)
+
+	if diff := cmp.Diff(got, expected, cmpopts.IgnoreUnexported(ststypes.PolicyDescriptorType{}, ststypes.Tag{})); diff != "" {
+		t.Errorf("unexpected options difference: %s", diff)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"maps"
 	"net/http"
 	"os"
+	"slices"
 	"strings"
 	"sync"
 
@@ -27,6 +28,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 )
 
 type AWSClient struct {
@@ -34,22 +36,26 @@ type AWSClient struct {
 	DefaultTagsConfig *tftags.DefaultConfig
 	IgnoreTagsConfig  *tftags.IgnoreConfig
 	Partition         string
-	Region            string
 	ServicePackages   map[string]ServicePackage
+	TagPolicyConfig   *tftags.PolicyConfig
 
 	awsConfig                 *aws_sdkv2.Config
 	clients                   map[string]any
+	concurrencyLimiters       map[string][]*concurrencyLimiter // From provider configuration.
 	conns                     map[string]any
 	dnsSuffix                 string
 	endpoints                 map[string]string // From provider configuration.
 	httpClient                *http.Client
 	lock                      sync.Mutex
 	logger                    baselogging.Logger
+	region                    string // From provider configuration.
 	session                   *session_sdkv1.Session
 	s3ExpressClient           *s3_sdkv2.Client
-	s3UsePathStyle            bool   // From provider configuration.
-	s3USEast1RegionalEndpoint string // From provider configuration.
-	stsRegion                 string // From provider configuration.
+	s3UsePathStyle            bool                       // From provider configuration.
+	s3USEast1RegionalEndpoint string                     // From provider configuration.
+	serviceLimiters           map[string]*serviceLimiter // From provider configuration.
+	stsRegion                 string                     // From provider configuration.
+	tracerProvider            *sdktrace.TracerProvider   // From provider configuration.
 }
 
 // CredentialsProvider returns the AWS SDK for Go v2 credentials provider.
@@ -64,11 +70,20 @@ func (c *AWSClient) AwsConfig(context.Context) aws_sdkv2.Config { // nosemgrep:c
 	return c.awsConfig.Copy()
 }
 
+// Region returns the AWS Region for the current operation.
+// This is any per-resource Region set in Context, otherwise the provider configured Region.
+func (c *AWSClient) Region(ctx context.Context) string {
+	if v, ok := FromContext(ctx); ok && v.Region != "" {
+		return v.Region
+	}
+	return c.region
+}
+
 // DSConnForRegion returns an AWS SDK For Go v1 DS API client for the specified AWS Region.
 // If the specified region is not the default a new "simple" client is created.
 // This new client does not use any configured endpoint override.
 func (c *AWSClient) DSConnForRegion(ctx context.Context, region string) *directoryservice_sdkv1.DirectoryService {
-	if region == c.Region {
+	if region == c.Region(ctx) {
 		return c.DSConn(ctx)
 	}
 	return directoryservice_sdkv1.New(c.session, aws_sdkv1.NewConfig().WithRegion(region))
@@ -78,7 +93,7 @@ func (c *AWSClient) DSConnForRegion(ctx context.Context, region string) *directo
 // If the specified region is not the default a new "simple" client is created.
 // This new client does not use any configured endpoint override.
 func (c *AWSClient) EFSConnForRegion(ctx context.Context, region string) *efs_sdkv1.EFS {
-	if region == c.Region {
+	if region == c.Region(ctx) {
 		return c.EFSConn(ctx)
 	}
 	return efs_sdkv1.New(c.session, aws_sdkv1.NewConfig().WithRegion(region))
@@ -88,7 +103,7 @@ func (c *AWSClient) EFSConnForRegion(ctx context.Context, region string) *efs_sd
 // If the specified region is not the default a new "simple" client is created.
 // This new client does not use any configured endpoint override.
 func (c *AWSClient) OpsWorksConnForRegion(ctx context.Context, region string) *opsworks_sdkv1.OpsWorks {
-	if region == c.Region {
+	if region == c.Region(ctx) {
 		return c.OpsWorksConn(ctx)
 	}
 	return opsworks_sdkv1.New(c.session, aws_sdkv1.NewConfig().WithRegion(region))
@@ -105,14 +120,14 @@ func (c *AWSClient) PartitionHostname(ctx context.Context, prefix string) string
 // e.g. PREFIX.us-west-2.amazonaws.com
 // The prefix should not contain a trailing period.
 func (c *AWSClient) RegionalHostname(ctx context.Context, prefix string) string {
-	return fmt.Sprintf("%s.%s.%s", prefix, c.Region, c.DNSSuffix(ctx))
+	return fmt.Sprintf("%s.%s.%s", prefix, c.Region(ctx), c.DNSSuffix(ctx))
 }
 
 // RDSConnForRegion returns an AWS SDK For Go v1 RDS API client for the specified AWS Region.
 // If the specified region is not the default a new "simple" client is created.
 // This new client does not use any configured endpoint override.
 func (c *AWSClient) RDSConnForRegion(ctx context.Context, region string) *rds_sdkv1.RDS {
-	if region == c.Region {
+	if region == c.Region(ctx) {
 		return c.RDSConn(ctx)
 	}
 	return rds_sdkv1.New(c.session, aws_sdkv1.NewConfig().WithRegion(region))
@@ -124,6 +139,16 @@ func (c *AWSClient) RDSConnForRegion(ctx context.Context, region string) *rds_sd
 func (c *AWSClient) S3ExpressClient(ctx context.Context) *s3_sdkv2.Client {
 	s3Client := c.S3Client(ctx)
 
+	// Only the provider configured Region's client is cached.
+	if region := c.Region(ctx); region != c.region {
+		if s3Client.Options().Region == names.GlobalRegionID {
+			return errs.Must(client[*s3_sdkv2.Client](ctx, c, names.S3, map[string]any{
+				"s3_us_east_1_regional_endpoint": "regional",
+			}))
+		}
+		return s3Client
+	}
+
 	c.lock.Lock() // OK since a non-default client is created.
 	defer c.lock.Unlock()
 
@@ -231,8 +256,8 @@ func (c *AWSClient) ReverseDNSPrefix(ctx context.Context) string {
 }
 
 // EC2RegionalPrivateDNSSuffix returns the EC2 private DNS suffix for the configured AWS Region.
-func (c *AWSClient) EC2RegionalPrivateDNSSuffix(context.Context) string {
-	region := c.Region
+func (c *AWSClient) EC2RegionalPrivateDNSSuffix(ctx context.Context) string {
+	region := c.Region(ctx)
 	if region == names.USEast1RegionID {
 		return "ec2.internal"
 	}
@@ -241,8 +266,8 @@ func (c *AWSClient) EC2RegionalPrivateDNSSuffix(context.Context) string {
 }
 
 // EC2RegionalPublicDNSSuffix returns the EC2 public DNS suffix for the configured AWS Region.
-func (c *AWSClient) EC2RegionalPublicDNSSuffix(context.Context) string {
-	region := c.Region
+func (c *AWSClient) EC2RegionalPublicDNSSuffix(ctx context.Context) string {
+	region := c.Region(ctx)
 	if region == names.USEast1RegionID {
 		return "compute-1"
 	}
@@ -266,11 +291,23 @@ func convertIPToDashIP(ip string) string {
 
 // apiClientConfig returns the AWS API client configuration parameters for the specified service.
 func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName string) map[string]any {
+	awsConfig, session := c.awsConfig, c.session
+	// Per-resource Region overrides the provider configured Region.
+	if region := c.Region(ctx); region != c.region {
+		if awsConfig != nil {
+			cfg := awsConfig.Copy()
+			cfg.Region = region
+			awsConfig = &cfg
+		}
+		if session != nil {
+			session = session.Copy(aws_sdkv1.NewConfig().WithRegion(region))
+		}
+	}
 	m := map[string]any{
-		"aws_sdkv2_config": c.awsConfig,
+		"aws_sdkv2_config": awsConfig,
 		"endpoint":         c.resolveEndpoint(ctx, servicePackageName),
 		"partition":        c.Partition,
-		"session":          c.session,
+		"session":          session,
 	}
 	switch servicePackageName {
 	case names.S3:
@@ -341,6 +378,15 @@ func resolveServiceBaseEndpoint(ctx context.Context, sdkID string, configs []any
 	return
 }
 
+// clientCacheKey returns the key used to cache the default API client for the specified service.
+// API clients for any per-resource Region are cached separately from those for the provider configured Region.
+func (c *AWSClient) clientCacheKey(ctx context.Context, servicePackageName string) string {
+	if region := c.Region(ctx); region != c.region {
+		return servicePackageName + "@" + region
+	}
+	return servicePackageName
+}
+
 // conn returns the AWS SDK for Go v1 API client for the specified service.
 // The default service client (`extra` is empty) is cached. In this case the AWSClient lock is held.
 // This function is not a method on `AWSClient` as methods can't be parameterized (https://go.googlesource.com/proposal/+/refs/heads/master/design/43651-type-parameters.md#no-parameterized-methods).
@@ -348,12 +394,13 @@ func conn[T any](ctx context.Context, c *AWSClient, servicePackageName string, e
 	ctx = tflog.SetField(ctx, "tf_aws.service_package", servicePackageName)
 
 	isDefault := len(extra) == 0
+	key := c.clientCacheKey(ctx, servicePackageName)
 	// Default service client is cached.
 	if isDefault {
 		c.lock.Lock()
 		defer c.lock.Unlock() // Runs at function exit, NOT block.
 
-		if raw, ok := c.conns[servicePackageName]; ok {
+		if raw, ok := c.conns[key]; ok {
 			if conn, ok := raw.(T); ok {
 				return conn, nil
 			} else {
@@ -397,12 +444,32 @@ func conn[T any](ctx context.Context, c *AWSClient, servicePackageName string, e
 
 	// Default service client is cached.
 	if isDefault {
-		c.conns[servicePackageName] = conn
+		c.conns[key] = conn
 	}
 
 	return conn, nil
 }
 
+// serviceAWSConfig returns the AWS SDK for Go v2 configuration for the specified service with any per-service concurrency and retry limits applied.
+func (c *AWSClient) serviceAWSConfig(servicePackageName string, awsConfig *aws_sdkv2.Config) *aws_sdkv2.Config {
+	concurrencyLimiters := c.concurrencyLimiters[servicePackageName]
+	serviceLimiter := c.serviceLimiters[servicePackageName]
+
+	if len(concurrencyLimiters) == 0 && serviceLimiter == nil {
+		return awsConfig
+	}
+
+	cfg := awsConfig.Copy()
+	if len(concurrencyLimiters) > 0 {
+		cfg.APIOptions = append(slices.Clone(cfg.APIOptions), withConcurrencyLimit(concurrencyLimiters))
+	}
+	if serviceLimiter != nil {
+		serviceLimiter.apply(&cfg)
+	}
+
+	return &cfg
+}
+
 // client returns the AWS SDK for Go v2 API client for the specified service.
 // The default service client (`extra` is empty) is cached. In this case the AWSClient lock is held.
 // This function is not a method on `AWSClient` as methods can't be parameterized (https://go.googlesource.com/proposal/+/refs/heads/master/design/43651-type-parameters.md#no-parameterized-methods).
@@ -410,12 +477,13 @@ func client[T any](ctx context.Context, c *AWSClient, servicePackageName string,
 	ctx = tflog.SetField(ctx, "tf_aws.service_package", servicePackageName)
 
 	isDefault := len(extra) == 0
+	key := c.clientCacheKey(ctx, servicePackageName)
 	// Default service client is cached.
 	if isDefault {
 		c.lock.Lock()
 		defer c.lock.Unlock() // Runs at function exit, NOT block.
 
-		if raw, ok := c.clients[servicePackageName]; ok {
+		if raw, ok := c.clients[key]; ok {
 			if client, ok := raw.(T); ok {
 				return client, nil
 			} else {
@@ -441,6 +509,9 @@ func client[T any](ctx context.Context, c *AWSClient, servicePackageName string,
 
 	config := c.apiClientConfig(ctx, servicePackageName)
 	maps.Copy(config, extra) // Extras overwrite per-service defaults.
+	if awsConfig, ok := config["aws_sdkv2_config"].(*aws_sdkv2.Config); ok {
+		config["aws_sdkv2_config"] = c.serviceAWSConfig(servicePackageName, awsConfig)
+	}
 	client, err := v.NewClient(ctx, config)
 	if err != nil {
 		var zero T
@@ -450,7 +521,7 @@ func client[T any](ctx context.Context, c *AWSClient, servicePackageName string,
 	// All customization for AWS SDK for Go v2 API clients must be done during construction.
 
 	if isDefault {
-		c.clients[servicePackageName] = client
+		c.clients[key] = client
 	}
 
 	return client, nil
@@ -64,7 +64,7 @@ func TestAWSClientRegionalHostname(t *testing.T) { // nosemgrep:ci.aws-in-func-n
 			Name: "AWS Commercial",
 			AWSClient: &AWSClient{
 				dnsSuffix: "amazonaws.com",
-				Region:    "us-west-2", //lintignore:AWSAT003
+				region:    "us-west-2", //lintignore:AWSAT003
 			},
 			Prefix:   "test",
 			Expected: "test.us-west-2.amazonaws.com", //lintignore:AWSAT003
@@ -73,7 +73,7 @@ func TestAWSClientRegionalHostname(t *testing.T) { // nosemgrep:ci.aws-in-func-n
 			Name: "AWS China",
 			AWSClient: &AWSClient{
 				dnsSuffix: "amazonaws.com.cn",
-				Region:    "cn-northwest-1", //lintignore:AWSAT003
+				region:    "cn-northwest-1", //lintignore:AWSAT003
 			},
 			Prefix:   "test",
 			Expected: "test.cn-northwest-1.amazonaws.com.cn", //lintignore:AWSAT003
@@ -108,7 +108,7 @@ func TestAWSClientEC2PrivateDNSNameForIP(t *testing.T) { // nosemgrep:ci.aws-in-
 			Name: "us-west-2",
 			AWSClient: &AWSClient{
 				dnsSuffix: "amazonaws.com",
-				Region:    "us-west-2", //lintignore:AWSAT003
+				region:    "us-west-2", //lintignore:AWSAT003
 			},
 			IP:       "10.20.30.40",
 			Expected: "ip-10-20-30-40.us-west-2.compute.internal", //lintignore:AWSAT003
@@ -117,7 +117,7 @@ func TestAWSClientEC2PrivateDNSNameForIP(t *testing.T) { // nosemgrep:ci.aws-in-
 			Name: "us-east-1",
 			AWSClient: &AWSClient{
 				dnsSuffix: "amazonaws.com",
-				Region:    "us-east-1", //lintignore:AWSAT003
+				region:    "us-east-1", //lintignore:AWSAT003
 			},
 			IP:       "10.20.30.40",
 			Expected: "ip-10-20-30-40.ec2.internal",
@@ -152,7 +152,7 @@ func TestAWSClientEC2PublicDNSNameForIP(t *testing.T) { // nosemgrep:ci.aws-in-f
 			Name: "us-west-2",
 			AWSClient: &AWSClient{
 				dnsSuffix: "amazonaws.com",
-				Region:    "us-west-2", //lintignore:AWSAT003
+				region:    "us-west-2", //lintignore:AWSAT003
 			},
 			IP:       "10.20.30.40",
 			Expected: "ec2-10-20-30-40.us-west-2.compute.amazonaws.com", //lintignore:AWSAT003
@@ -161,7 +161,7 @@ func TestAWSClientEC2PublicDNSNameForIP(t *testing.T) { // nosemgrep:ci.aws-in-f
 			Name: "us-east-1",
 			AWSClient: &AWSClient{
 				dnsSuffix: "amazonaws.com",
-				Region:    "us-east-1", //lintignore:AWSAT003
+				region:    "us-east-1", //lintignore:AWSAT003
 			},
 			IP:       "10.20.30.40",
 			Expected: "ec2-10-20-30-40.compute-1.amazonaws.com",
@@ -181,3 +181,62 @@ func TestAWSClientEC2PublicDNSNameForIP(t *testing.T) { // nosemgrep:ci.aws-in-f
 		})
 	}
 }
+
+func TestAWSClientRegion(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	client := &AWSClient{
+		dnsSuffix: "amazonaws.com",
+		region:    "us-west-2", //lintignore:AWSAT003
+	}
+	testCases := []struct {
+		Name             string
+		Region           string
+		ExpectedRegion   string
+		ExpectedHostname string
+		ExpectedCacheKey string
+	}{
+		{
+			Name:             "provider Region",
+			ExpectedRegion:   "us-west-2",                    //lintignore:AWSAT003
+			ExpectedHostname: "test.us-west-2.amazonaws.com", //lintignore:AWSAT003
+			ExpectedCacheKey: "logs",
+		},
+		{
+			Name:             "same Region",
+			Region:           "us-west-2",                    //lintignore:AWSAT003
+			ExpectedRegion:   "us-west-2",                    //lintignore:AWSAT003
+			ExpectedHostname: "test.us-west-2.amazonaws.com", //lintignore:AWSAT003
+			ExpectedCacheKey: "logs",
+		},
+		{
+			Name:             "per-resource Region",
+			Region:           "eu-west-1",                    //lintignore:AWSAT003
+			ExpectedRegion:   "eu-west-1",                    //lintignore:AWSAT003
+			ExpectedHostname: "test.eu-west-1.amazonaws.com", //lintignore:AWSAT003
+			ExpectedCacheKey: "logs@eu-west-1",               //lintignore:AWSAT003
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := NewResourceContext(context.TODO(), "logs", "Log Group")
+			if v, ok := FromContext(ctx); ok {
+				v.Region = testCase.Region
+			}
+
+			if got, want := client.Region(ctx), testCase.ExpectedRegion; got != want {
+				t.Errorf("Region: got %s, expected %s", got, want)
+			}
+			if got, want := client.RegionalHostname(ctx, "test"), testCase.ExpectedHostname; got != want {
+				t.Errorf("RegionalHostname: got %s, expected %s", got, want)
+			}
+			if got, want := client.clientCacheKey(ctx, "logs"), testCase.ExpectedCacheKey; got != want {
+				t.Errorf("clientCacheKey: got %s, expected %s", got, want)
+			}
+		})
+	}
+}
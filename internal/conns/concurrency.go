@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"slices"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
+)
+
+// ServiceConcurrency limits the number of concurrent API operations made by a service's clients.
+// If Operations is empty the limit is shared by all of the service's operations,
+// otherwise it is shared by the named operations.
+type ServiceConcurrency struct {
+	Limit      int
+	Operations []string
+}
+
+type concurrencyLimiter struct {
+	operations []string
+	semaphore  tfsync.Semaphore
+}
+
+func newConcurrencyLimiters(tfList []ServiceConcurrency) []*concurrencyLimiter {
+	limiters := make([]*concurrencyLimiter, 0, len(tfList))
+
+	for _, v := range tfList {
+		limiters = append(limiters, &concurrencyLimiter{
+			operations: v.Operations,
+			semaphore:  tfsync.NewSemaphore(v.Limit),
+		})
+	}
+
+	return limiters
+}
+
+func (l *concurrencyLimiter) appliesTo(operation string) bool {
+	return len(l.operations) == 0 || slices.Contains(l.operations, operation)
+}
+
+// concurrencyLimitMiddleware returns a middleware that holds the applicable limiters' semaphores for the duration of an API operation, including any retries.
+// Semaphores are always acquired in the same order to avoid deadlock.
+func concurrencyLimitMiddleware(limiters []*concurrencyLimiter) middleware.InitializeMiddleware {
+	return middleware.InitializeMiddlewareFunc("TFAWSConcurrencyLimit", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+		operation := awsmiddleware.GetOperationName(ctx)
+
+		for _, limiter := range limiters {
+			if !limiter.appliesTo(operation) {
+				continue
+			}
+
+			if err := limiter.semaphore.Acquire(ctx); err != nil {
+				return middleware.InitializeOutput{}, middleware.Metadata{}, err
+			}
+			defer limiter.semaphore.Notify()
+		}
+
+		return next.HandleInitialize(ctx, in)
+	})
+}
+
+func withConcurrencyLimit(limiters []*concurrencyLimiter) func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		// Run after the operation name has been registered.
+		return stack.Initialize.Add(concurrencyLimitMiddleware(limiters), middleware.After)
+	}
+}
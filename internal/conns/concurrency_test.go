@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+)
+
+func TestConcurrencyLimitMiddleware(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		config      []ServiceConcurrency
+		operation   string
+		expectedMax int32
+	}{
+		"service": {
+			config:      []ServiceConcurrency{{Limit: 2}},
+			operation:   "CreateFunction",
+			expectedMax: 2,
+		},
+		"operation": {
+			config:      []ServiceConcurrency{{Limit: 1, Operations: []string{"CreateAccount"}}},
+			operation:   "CreateAccount",
+			expectedMax: 1,
+		},
+		"service and operation": {
+			config:      []ServiceConcurrency{{Limit: 3}, {Limit: 1, Operations: []string{"CreateAccount", "CloseAccount"}}},
+			operation:   "CloseAccount",
+			expectedMax: 1,
+		},
+		"other operation": {
+			config:      []ServiceConcurrency{{Limit: 1, Operations: []string{"CreateAccount"}}},
+			operation:   "DescribeAccount",
+			expectedMax: 5,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			const n = 5
+			var inFlight, maxInFlight atomic.Int32
+
+			handler := middleware.InitializeHandlerFunc(func(ctx context.Context, in middleware.InitializeInput) (middleware.InitializeOutput, middleware.Metadata, error) {
+				v := inFlight.Add(1)
+				defer inFlight.Add(-1)
+
+				for {
+					if m := maxInFlight.Load(); v <= m || maxInFlight.CompareAndSwap(m, v) {
+						break
+					}
+				}
+
+				time.Sleep(50 * time.Millisecond)
+
+				return middleware.InitializeOutput{}, middleware.Metadata{}, nil
+			})
+			limit := concurrencyLimitMiddleware(newConcurrencyLimiters(testCase.config))
+			metadata := awsmiddleware.RegisterServiceMetadata{OperationName: testCase.operation}
+
+			var wg sync.WaitGroup
+			for range n {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+
+					_, _, err := metadata.HandleInitialize(context.Background(), middleware.InitializeInput{}, middleware.InitializeHandlerFunc(func(ctx context.Context, in middleware.InitializeInput) (middleware.InitializeOutput, middleware.Metadata, error) {
+						return limit.HandleInitialize(ctx, in, handler)
+					}))
+
+					if err != nil {
+						t.Errorf("unexpected error: %s", err)
+					}
+				}()
+			}
+			wg.Wait()
+
+			if got, expected := maxInFlight.Load(), testCase.expectedMax; got > expected {
+				t.Errorf("maximum concurrent operations: got %d, expected at most %d", got, expected)
+			}
+		})
+	}
+}
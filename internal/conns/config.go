@@ -29,7 +29,7 @@ import (
 type Config struct {
 	AccessKey                      string
 	AllowedAccountIds              []string
-	AssumeRole                     *awsbase.AssumeRole
+	AssumeRole                     []awsbase.AssumeRole // Assumed in order, each using the credentials of the previous.
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
@@ -50,6 +50,8 @@ type Config struct {
 	S3UsePathStyle                 bool
 	S3USEast1RegionalEndpoint      string
 	SecretKey                      string
+	ServiceConcurrency             map[string][]ServiceConcurrency
+	ServiceLimits                  map[string]ServiceLimits
 	SharedConfigFiles              []string
 	SharedCredentialsFiles         []string
 	SkipCredsValidation            bool
@@ -57,9 +59,11 @@ type Config struct {
 	SkipRequestingAccountId        bool
 	STSRegion                      string
 	SuppressDebugLog               bool
+	TagPolicyConfig                *tftags.PolicyConfig
 	TerraformVersion               string
 	Token                          string
 	TokenBucketRateLimiterCapacity int
+	Tracing                        *TracingConfig
 	UseDualStackEndpoint           bool
 	UseFIPSEndpoint                bool
 }
@@ -114,8 +118,12 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		UseFIPSEndpoint:                c.UseFIPSEndpoint,
 	}
 
-	if c.AssumeRole != nil && c.AssumeRole.RoleARN != "" {
-		awsbaseConfig.AssumeRole = c.AssumeRole
+	if d := c.validateAssumeRoles(); d.HasError() {
+		return nil, append(diags, d...)
+	}
+
+	if len(c.AssumeRole) > 0 && c.AssumeRole[0].RoleARN != "" {
+		awsbaseConfig.AssumeRole = &c.AssumeRole[0]
 	}
 
 	if c.CustomCABundle != "" {
@@ -148,9 +156,14 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	ctx, cfg, awsDiags := awsbase.GetAwsConfig(ctx, &awsbaseConfig)
 
 	for _, d := range awsDiags {
+		summary := d.Summary()
+		if awsbase.IsCannotAssumeRoleError(d) {
+			summary = assumeRoleHopSummary(summary, 0, len(c.AssumeRole))
+		}
+
 		diags = append(diags, diag.Diagnostic{
 			Severity: baseSeverityToSDKSeverity(d.Severity()),
-			Summary:  d.Summary(),
+			Summary:  summary,
 			Detail:   d.Detail(),
 		})
 	}
@@ -159,6 +172,17 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, diags
 	}
 
+	if len(c.AssumeRole) > 1 {
+		credentials, d := c.chainAssumeRoles(ctx, cfg)
+		diags = append(diags, d...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		cfg.Credentials = credentials
+	}
+
 	if !c.SkipRegionValidation {
 		if err := basevalidation.SupportedRegion(cfg.Region); err != nil {
 			return nil, sdkdiag.AppendFromErr(diags, err)
@@ -201,7 +225,7 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 
 	err := awsbaseConfig.VerifyAccountIDAllowed(accountID)
 	if err != nil {
-		return nil, sdkdiag.AppendErrorf(diags, err.Error())
+		return nil, sdkdiag.AppendFromErr(diags, err)
 	}
 
 	dnsSuffix := "amazonaws.com"
@@ -214,17 +238,36 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.dnsSuffix = dnsSuffix
 	client.IgnoreTagsConfig = c.IgnoreTagsConfig
 	client.Partition = partition
-	client.Region = c.Region
+	client.TagPolicyConfig = c.TagPolicyConfig
 	client.SetHTTPClient(ctx, session.Config.HTTPClient) // Must be called while client.Session is nil.
 	client.session = session
 
+	if v := c.Tracing; v != nil {
+		tracerProvider, err := newTracerProvider(ctx, v)
+		if err != nil {
+			return nil, sdkdiag.AppendFromErr(diags, err)
+		}
+
+		client.tracerProvider = tracerProvider
+		cfg.APIOptions = append(cfg.APIOptions, withTracing(client.Tracer()))
+	}
+
 	// Used for lazy-loading AWS API clients.
 	client.awsConfig = &cfg
 	client.clients = make(map[string]any, 0)
+	client.concurrencyLimiters = make(map[string][]*concurrencyLimiter, len(c.ServiceConcurrency))
+	for k, v := range c.ServiceConcurrency {
+		client.concurrencyLimiters[k] = newConcurrencyLimiters(v)
+	}
 	client.conns = make(map[string]any, 0)
 	client.endpoints = c.Endpoints
 	client.logger = logger
+	client.region = c.Region
 	client.s3UsePathStyle = c.S3UsePathStyle
+	client.serviceLimiters = make(map[string]*serviceLimiter, len(c.ServiceLimits))
+	for k, v := range c.ServiceLimits {
+		client.serviceLimiters[k] = newServiceLimiter(v)
+	}
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
 	client.stsRegion = c.STSRegion
 
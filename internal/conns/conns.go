@@ -19,6 +19,27 @@ type ServicePackage interface {
 	ServicePackageName() string
 }
 
+// ServicePackageWithEphemeralResources is an interface that extends ServicePackage with ephemeral resources.
+// Ephemeral resources are resources that are not stored in state.
+type ServicePackageWithEphemeralResources interface {
+	ServicePackage
+	EphemeralResources(context.Context) []*types.ServicePackageEphemeralResource
+}
+
+// ServicePackageWithActions is an interface that extends ServicePackage with actions.
+// Actions are imperative operations invoked outside of the resource lifecycle.
+type ServicePackageWithActions interface {
+	ServicePackage
+	Actions(context.Context) []*types.ServicePackageAction
+}
+
+// ServicePackageWithSDKListResources is an interface that extends ServicePackage with list resources for Plugin SDK resources.
+// List resources enumerate existing objects, for example for bulk discovery and import with `terraform query`.
+type ServicePackageWithSDKListResources interface {
+	ServicePackage
+	SDKListResources(context.Context) []*types.ServicePackageSDKListResource
+}
+
 type (
 	contextKeyType int
 )
@@ -29,11 +50,24 @@ var (
 
 // InContext represents the resource information kept in Context.
 type InContext struct {
+	IsAction           bool   // Action?
 	IsDataSource       bool   // Data source?
+	IsEphemeral        bool   // Ephemeral resource?
+	Region             string // Per-resource AWS Region, overriding the provider configured Region
 	ResourceName       string // Friendly resource name, e.g. "Subnet"
 	ServicePackageName string // Canonical name defined as a constant in names package
 }
 
+func NewActionContext(ctx context.Context, servicePackageName, actionName string) context.Context {
+	v := InContext{
+		IsAction:           true,
+		ResourceName:       actionName,
+		ServicePackageName: servicePackageName,
+	}
+
+	return context.WithValue(ctx, contextKey, &v)
+}
+
 func NewDataSourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
 	v := InContext{
 		IsDataSource:       true,
@@ -44,6 +78,16 @@ func NewDataSourceContext(ctx context.Context, servicePackageName, resourceName
 	return context.WithValue(ctx, contextKey, &v)
 }
 
+func NewEphemeralResourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
+	v := InContext{
+		IsEphemeral:        true,
+		ResourceName:       resourceName,
+		ServicePackageName: servicePackageName,
+	}
+
+	return context.WithValue(ctx, contextKey, &v)
+}
+
 func NewResourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
 	v := InContext{
 		ResourceName:       resourceName,
@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// ImportIDRegionSeparator separates a resource's import ID from an optional per-resource AWS Region,
+	// e.g. `my-log-group@eu-west-1`.
+	ImportIDRegionSeparator = "@"
+)
+
+var regionRegexp = regexache.MustCompile(`^[a-z]{2}(-[a-z]+)+-\d$`)
+
+// WithRegion returns a copy of ctx in which the resource information has the specified per-resource AWS Region.
+// AWS API clients subsequently obtained using the returned Context are scoped to that Region.
+func WithRegion(ctx context.Context, region string) context.Context {
+	v, ok := FromContext(ctx)
+	if !ok {
+		return ctx
+	}
+
+	inContext := *v
+	inContext.Region = region
+
+	return context.WithValue(ctx, contextKey, &inContext)
+}
+
+// SplitImportIDRegion splits any per-resource AWS Region suffix from a resource's import ID.
+// The suffix is only recognized if it is a well-formed AWS Region name.
+func SplitImportIDRegion(id string) (string, string) {
+	i := strings.LastIndex(id, ImportIDRegionSeparator)
+	if i <= 0 {
+		return id, ""
+	}
+
+	if region := id[i+len(ImportIDRegionSeparator):]; regionRegexp.MatchString(region) {
+		return id[:i], region
+	}
+
+	return id, ""
+}
+
+// ValidateRegion checks that a per-resource AWS Region is in the provider configured AWS partition.
+func (c *AWSClient) ValidateRegion(region string) error {
+	if partition := names.PartitionForRegion(region); partition != c.Partition {
+		return fmt.Errorf("per-resource Region (%s) is not in the provider's AWS partition (%s)", region, c.Partition)
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"testing"
+)
+
+func TestWithRegion(t *testing.T) {
+	t.Parallel()
+
+	ctx := NewResourceContext(context.TODO(), "logs", "Log Group")
+	regionalCtx := WithRegion(ctx, "eu-west-1") //lintignore:AWSAT003
+
+	if v, ok := FromContext(ctx); !ok || v.Region != "" {
+		t.Errorf("original Context modified")
+	}
+
+	v, ok := FromContext(regionalCtx)
+	if !ok {
+		t.Fatal("resource information not found in Context")
+	}
+	if got, want := v.Region, "eu-west-1"; got != want { //lintignore:AWSAT003
+		t.Errorf("Region: got %s, expected %s", got, want)
+	}
+	if got, want := v.ServicePackageName, "logs"; got != want {
+		t.Errorf("ServicePackageName: got %s, expected %s", got, want)
+	}
+}
+
+func TestSplitImportIDRegion(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name           string
+		ID             string
+		ExpectedID     string
+		ExpectedRegion string
+	}{
+		{
+			Name:       "empty",
+			ID:         "",
+			ExpectedID: "",
+		},
+		{
+			Name:       "no Region",
+			ID:         "my-log-group",
+			ExpectedID: "my-log-group",
+		},
+		{
+			Name:           "Region",
+			ID:             "my-log-group@eu-west-1", //lintignore:AWSAT003
+			ExpectedID:     "my-log-group",
+			ExpectedRegion: "eu-west-1", //lintignore:AWSAT003
+		},
+		{
+			Name:           "multi-part ID and Region",
+			ID:             "vpc-12345678,subnet-12345678@us-gov-west-1", //lintignore:AWSAT003
+			ExpectedID:     "vpc-12345678,subnet-12345678",
+			ExpectedRegion: "us-gov-west-1", //lintignore:AWSAT003
+		},
+		{
+			Name:       "email address",
+			ID:         "user@example.com",
+			ExpectedID: "user@example.com",
+		},
+		{
+			Name:       "leading separator",
+			ID:         "@eu-west-1", //lintignore:AWSAT003
+			ExpectedID: "@eu-west-1", //lintignore:AWSAT003
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			gotID, gotRegion := SplitImportIDRegion(testCase.ID)
+
+			if gotID != testCase.ExpectedID {
+				t.Errorf("ID: got %s, expected %s", gotID, testCase.ExpectedID)
+			}
+			if gotRegion != testCase.ExpectedRegion {
+				t.Errorf("Region: got %s, expected %s", gotRegion, testCase.ExpectedRegion)
+			}
+		})
+	}
+}
+
+func TestAWSClientValidateRegion(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	client := &AWSClient{
+		Partition: "aws",
+	}
+
+	if err := client.ValidateRegion("eu-west-1"); err != nil { //lintignore:AWSAT003
+		t.Errorf("unexpected error: %s", err)
+	}
+	if err := client.ValidateRegion("cn-north-1"); err == nil { //lintignore:AWSAT003
+		t.Error("expected error")
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/ratelimit"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+)
+
+// ServiceLimits overrides the provider-level retry settings for a service's API clients.
+// Zero values inherit the provider-level setting.
+type ServiceLimits struct {
+	MaxRetries                     int
+	TokenBucketRateLimiterCapacity int
+}
+
+type serviceLimiter struct {
+	maxRetries  int
+	rateLimiter retry.RateLimiter
+}
+
+func newServiceLimiter(limits ServiceLimits) *serviceLimiter {
+	limiter := &serviceLimiter{
+		maxRetries: limits.MaxRetries,
+	}
+
+	// The token bucket is shared by all of the service's API clients.
+	if v := limits.TokenBucketRateLimiterCapacity; v > 0 {
+		limiter.rateLimiter = ratelimit.NewTokenRateLimit(uint(v))
+	}
+
+	return limiter
+}
+
+// apply applies the limits to the specified AWS SDK for Go v2 configuration.
+// Service packages that customize the Retryer returned by cfg.Retryer, e.g. via AddIsErrorRetryables, wrap the limited Retryer.
+func (l *serviceLimiter) apply(cfg *aws.Config) {
+	if l.maxRetries > 0 {
+		cfg.RetryMaxAttempts = l.maxRetries
+	}
+
+	if l.rateLimiter != nil {
+		if newRetryer := cfg.Retryer; newRetryer != nil {
+			cfg.Retryer = func() aws.Retryer {
+				r := newRetryer()
+				if v, ok := r.(aws.RetryerV2); ok {
+					return AddRateLimiter(v, l.rateLimiter)
+				}
+				return r
+			}
+		}
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/ratelimit"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAWSClientServiceAWSConfig(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	ctx := context.Background()
+	awsConfig := &aws.Config{
+		RetryMaxAttempts: 25,
+		Retryer: func() aws.Retryer {
+			return retry.NewStandard(func(o *retry.StandardOptions) {
+				o.RateLimiter = ratelimit.None
+			})
+		},
+	}
+	c := &AWSClient{
+		serviceLimiters: map[string]*serviceLimiter{
+			names.Route53: newServiceLimiter(ServiceLimits{
+				MaxRetries:                     50,
+				TokenBucketRateLimiterCapacity: 5,
+			}),
+		},
+	}
+
+	if got := c.serviceAWSConfig(names.EC2, awsConfig); got != awsConfig {
+		t.Errorf("expected unchanged configuration for %s", names.EC2)
+	}
+
+	cfg := c.serviceAWSConfig(names.Route53, awsConfig)
+
+	if got, expected := cfg.RetryMaxAttempts, 50; got != expected {
+		t.Errorf("RetryMaxAttempts: got %d, expected %d", got, expected)
+	}
+	if got, expected := awsConfig.RetryMaxAttempts, 25; got != expected {
+		t.Errorf("provider-level RetryMaxAttempts modified: got %d, expected %d", got, expected)
+	}
+
+	// Per-service limits compose with per-service retryables.
+	r := AddIsErrorRetryables(cfg.Retryer().(aws.RetryerV2), retry.IsErrorRetryableFunc(func(err error) aws.Ternary {
+		if errs.Contains(err, "testing") {
+			return aws.TrueTernary
+		}
+		return aws.UnknownTernary
+	}))
+	opErr := errors.New("testing")
+
+	if !r.IsErrorRetryable(opErr) {
+		t.Errorf("expected error to be retryable")
+	}
+
+	if _, err := r.GetRetryToken(ctx, opErr); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// The token bucket is shared by all of the service's API clients.
+	if _, err := cfg.Retryer().(aws.RetryerV2).GetRetryToken(ctx, opErr); !errs.IsA[ratelimit.QuotaExceededError](err) {
+		t.Fatalf("expected QuotaExceededError, got: %v", err)
+	}
+}
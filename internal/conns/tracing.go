@@ -0,0 +1,252 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"sync/atomic"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-provider-aws/version"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	tracerName = "github.com/hashicorp/terraform-provider-aws"
+)
+
+// TracingConfig configures the export of OpenTelemetry traces of resource operations and the AWS API calls they make.
+// Traces are exported to File if set, otherwise to Endpoint.
+type TracingConfig struct {
+	Endpoint string // OTLP/HTTP collector endpoint, e.g. `localhost:4318`. Defaults to the OTLP exporter environment variables.
+	File     string // Path of a file to which traces are appended in OTLP JSON format.
+	Insecure bool   // Whether to export to Endpoint using HTTP rather than HTTPS.
+}
+
+func newTracerProvider(ctx context.Context, config *TracingConfig) (*sdktrace.TracerProvider, error) {
+	var client otlptrace.Client
+	if config.File != "" {
+		client = &otlpFileClient{path: config.File}
+	} else {
+		var opts []otlptracehttp.Option
+		if config.Endpoint != "" {
+			opts = append(opts, otlptracehttp.WithEndpoint(config.Endpoint))
+		}
+		if config.Insecure {
+			opts = append(opts, otlptracehttp.WithInsecure())
+		}
+		client = otlptracehttp.NewClient(opts...)
+	}
+
+	exporter, err := otlptrace.New(ctx, client)
+	if err != nil {
+		return nil, fmt.Errorf("creating OTLP trace exporter: %w", err)
+	}
+
+	return sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewSchemaless(
+			attribute.String("service.name", "terraform-provider-aws"),
+			attribute.String("service.version", version.ProviderVersion),
+		)),
+	), nil
+}
+
+// otlpFileClient is an OTLP trace client that appends each export request to a file as a line of OTLP JSON.
+// The file can be read by the OpenTelemetry Collector's `otlpjsonfile` receiver.
+type otlpFileClient struct {
+	path string
+
+	mu   sync.Mutex
+	file *os.File
+}
+
+func (c *otlpFileClient) Start(context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	file, err := os.OpenFile(c.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	c.file = file
+
+	return nil
+}
+
+func (c *otlpFileClient) Stop(context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.file == nil {
+		return nil
+	}
+
+	err := c.file.Close()
+	c.file = nil
+
+	return err
+}
+
+func (c *otlpFileClient) UploadTraces(_ context.Context, spans []*tracepb.ResourceSpans) error {
+	b, err := protojson.Marshal(&coltracepb.ExportTraceServiceRequest{
+		ResourceSpans: spans,
+	})
+	if err != nil {
+		return err
+	}
+	b = append(b, '\n')
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.file == nil {
+		return fmt.Errorf("OTLP trace file (%s) is closed", c.path)
+	}
+
+	_, err = c.file.Write(b)
+
+	return err
+}
+
+// Tracer returns the OpenTelemetry tracer for resource operations and AWS API calls.
+// If tracing is not configured a no-op tracer is returned.
+func (c *AWSClient) Tracer() trace.Tracer {
+	if c.tracerProvider == nil {
+		return noop.NewTracerProvider().Tracer(tracerName)
+	}
+
+	return c.tracerProvider.Tracer(tracerName)
+}
+
+// ShutdownTracing exports any spans not yet exported and stops the trace exporter, closing any trace file.
+// It must be called once, when the provider stops.
+func (c *AWSClient) ShutdownTracing(ctx context.Context) error {
+	if c.tracerProvider == nil {
+		return nil
+	}
+
+	return c.tracerProvider.Shutdown(ctx)
+}
+
+// apiCallCounts totals the AWS API calls made during a resource operation.
+type apiCallCounts struct {
+	calls     atomic.Int64
+	retries   atomic.Int64
+	throttles atomic.Int64
+}
+
+type apiCallCountsKey struct{}
+
+// StartOperationSpan starts a span for a resource or data source CRUD operation.
+// AWS API calls made using the returned Context are recorded as child spans and their retries and throttles are totaled on the operation's span.
+func (c *AWSClient) StartOperationSpan(ctx context.Context, typeName, operation string) context.Context {
+	if c.tracerProvider == nil {
+		return ctx
+	}
+
+	ctx, _ = c.Tracer().Start(ctx, typeName+"."+operation, trace.WithAttributes(
+		attribute.String("terraform.type_name", typeName),
+		attribute.String("terraform.operation", operation),
+	))
+
+	return context.WithValue(ctx, apiCallCountsKey{}, &apiCallCounts{})
+}
+
+// EndOperationSpan ends the span started by StartOperationSpan, recording any error.
+// It does nothing if no operation span was started.
+// The span is exported in a batch with other spans.
+func (c *AWSClient) EndOperationSpan(ctx context.Context, err error) {
+	if c.tracerProvider == nil {
+		return
+	}
+
+	v, ok := ctx.Value(apiCallCountsKey{}).(*apiCallCounts)
+	if !ok {
+		return
+	}
+
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(
+		attribute.Int64("aws.api_calls", v.calls.Load()),
+		attribute.Int64("aws.retries", v.retries.Load()),
+		attribute.Int64("aws.throttles", v.throttles.Load()),
+	)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// tracingMiddleware returns a middleware that records an AWS API operation, including any retries, as a span.
+func tracingMiddleware(tracer trace.Tracer) middleware.InitializeMiddleware {
+	return middleware.InitializeMiddlewareFunc("TFAWSTracing", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+		serviceID, operation := awsmiddleware.GetServiceID(ctx), awsmiddleware.GetOperationName(ctx)
+		ctx, span := tracer.Start(ctx, serviceID+"."+operation, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(
+			attribute.String("rpc.system", "aws-api"),
+			attribute.String("rpc.service", serviceID),
+			attribute.String("rpc.method", operation),
+			attribute.String("aws.region", awsmiddleware.GetRegion(ctx)),
+		))
+		defer span.End()
+
+		out, metadata, err := next.HandleInitialize(ctx, in)
+
+		var attempts, throttles int
+		if v, ok := retry.GetAttemptResults(metadata); ok {
+			attempts = len(v.Results)
+			for _, v := range v.Results {
+				if v.Err != nil && retry.IsErrorThrottles(retry.DefaultThrottles).IsErrorThrottle(v.Err) == aws.TrueTernary {
+					throttles++
+				}
+			}
+		}
+		retries := max(attempts-1, 0)
+
+		span.SetAttributes(
+			attribute.Int("aws.attempts", attempts),
+			attribute.Int("aws.retries", retries),
+			attribute.Int("aws.throttles", throttles),
+		)
+		if v, ok := awsmiddleware.GetRequestIDMetadata(metadata); ok {
+			span.SetAttributes(attribute.String("aws.request_id", v))
+		}
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+
+		if v, ok := ctx.Value(apiCallCountsKey{}).(*apiCallCounts); ok {
+			v.calls.Add(1)
+			v.retries.Add(int64(retries))
+			v.throttles.Add(int64(throttles))
+		}
+
+		return out, metadata, err
+	})
+}
+
+func withTracing(tracer trace.Tracer) func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		// Run after the service and operation names have been registered.
+		return stack.Initialize.Add(tracingMiddleware(tracer), middleware.After)
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+func TestTracing_file(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "traces.jsonl")
+
+	tracerProvider, err := newTracerProvider(ctx, &TracingConfig{File: path})
+	if err != nil {
+		t.Fatalf("newTracerProvider: %s", err)
+	}
+
+	c := &AWSClient{tracerProvider: tracerProvider}
+
+	stack := middleware.NewStack("test", smithyhttp.NewStackRequest)
+	if err := stack.Initialize.Add(&awsmiddleware.RegisterServiceMetadata{ServiceID: "Test", OperationName: "DescribeThing"}, middleware.Before); err != nil {
+		t.Fatalf("adding service metadata middleware: %s", err)
+	}
+	if err := withTracing(c.Tracer())(stack); err != nil {
+		t.Fatalf("adding tracing middleware: %s", err)
+	}
+	handler := middleware.DecorateHandler(middleware.HandlerFunc(func(context.Context, any) (any, middleware.Metadata, error) {
+		return nil, middleware.Metadata{}, errors.New("ThingNotFound")
+	}), stack)
+
+	ctx = c.StartOperationSpan(ctx, "aws_test_thing", "Read")
+	_, _, apiErr := handler.Handle(ctx, nil)
+	if apiErr == nil {
+		t.Fatal("expected API call error")
+	}
+
+	counts, ok := ctx.Value(apiCallCountsKey{}).(*apiCallCounts)
+	if !ok {
+		t.Fatal("no API call counts in Context")
+	}
+	if got, want := counts.calls.Load(), int64(1); got != want {
+		t.Errorf("API calls = %d, want %d", got, want)
+	}
+
+	c.EndOperationSpan(ctx, apiErr)
+
+	if err := c.ShutdownTracing(ctx); err != nil {
+		t.Fatalf("ShutdownTracing: %s", err)
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading traces: %s", err)
+	}
+	traces := string(b)
+
+	for _, want := range []string{"aws_test_thing.Read", "Test.DescribeThing", "aws.api_calls", "aws.throttles", "ThingNotFound"} {
+		if !strings.Contains(traces, want) {
+			t.Errorf("traces do not contain %q: %s", want, traces)
+		}
+	}
+}
+
+func TestTracing_notConfigured(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	c := &AWSClient{}
+
+	if got := c.StartOperationSpan(ctx, "aws_test_thing", "Read"); got != ctx {
+		t.Error("expected unchanged Context")
+	}
+	c.EndOperationSpan(ctx, nil)
+
+	if err := c.ShutdownTracing(ctx); err != nil {
+		t.Errorf("ShutdownTracing: %s", err)
+	}
+}
+
+func TestTracing_noOperationSpan(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "traces.jsonl")
+
+	tracerProvider, err := newTracerProvider(ctx, &TracingConfig{File: path})
+	if err != nil {
+		t.Fatalf("newTracerProvider: %s", err)
+	}
+
+	c := &AWSClient{tracerProvider: tracerProvider}
+
+	// A span that isn't an operation span isn't ended.
+	ctx, _ = c.Tracer().Start(ctx, "parent")
+	c.EndOperationSpan(ctx, errors.New("Before interceptor failed"))
+
+	if err := c.ShutdownTracing(ctx); err != nil {
+		t.Fatalf("ShutdownTracing: %s", err)
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("reading traces: %s", err)
+	}
+
+	if traces := string(b); strings.Contains(traces, "parent") {
+		t.Errorf("traces contain unexpected span: %s", traces)
+	}
+}
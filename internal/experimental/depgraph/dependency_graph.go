@@ -186,6 +186,36 @@ func (g *Graph) OverallOrder() ([]string, error) {
 	return order, nil
 }
 
+// Waves returns the overall processing order for the dependency graph grouped into waves.
+// Every node in a wave depends only on nodes in earlier waves, so the nodes within a wave can be processed concurrently.
+// Returns an error if a dependency cycle is detected.
+func (g *Graph) Waves() ([][]string, error) {
+	order, err := g.OverallOrder()
+
+	if err != nil {
+		return nil, err
+	}
+
+	waves := make([][]string, 0)
+	levels := make(map[string]int, len(order))
+
+	// OverallOrder guarantees that a node's dependencies precede it.
+	for _, node := range order {
+		level := 0
+		for _, dep := range g.outgoingEdges[node] {
+			level = max(level, levels[dep]+1)
+		}
+		levels[node] = level
+
+		if level == len(waves) {
+			waves = append(waves, make([]string, 0))
+		}
+		waves[level] = append(waves[level], node)
+	}
+
+	return waves, nil
+}
+
 // depthFirstSearch returns a Topological Sort using Depth-First-Search on a set of edges.
 // Returns an error if a dependency cycle is detected.
 func depthFirstSearch(edges map[string][]string) func(s string) ([]string, error) {
@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package fileset selects the files in a local directory using include and exclude patterns.
+package fileset
+
+import (
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	homedir "github.com/mitchellh/go-homedir"
+)
+
+// File is a file selected from a directory.
+type File struct {
+	Name string      // Slash-separated path relative to the directory.
+	Path string      // Path on the local filesystem.
+	Info fs.FileInfo // Information about the file, following any symbolic link.
+}
+
+// Walk returns the regular files in the specified directory that match any of the include patterns and none of the exclude patterns.
+// Files are returned in lexical order of their names. Symbolic links to files are followed, symbolic links to directories are not.
+// If no include patterns are specified all files are included. A directory that matches an exclude pattern is skipped entirely.
+func Walk(dir string, includes, excludes []string) ([]File, error) {
+	root, err := homedir.Expand(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(includes) == 0 {
+		includes = []string{"**"}
+	}
+
+	var files []File
+
+	err = filepath.WalkDir(root, func(p string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(root, p)
+		if err != nil {
+			return err
+		}
+		if rel == "." {
+			return nil
+		}
+		name := filepath.ToSlash(rel)
+
+		if MatchAny(excludes, name) {
+			if entry.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if entry.IsDir() || !MatchAny(includes, name) {
+			return nil
+		}
+
+		fi, err := os.Stat(p)
+		if err != nil {
+			return err
+		}
+		if !fi.Mode().IsRegular() {
+			return nil
+		}
+
+		files = append(files, File{
+			Name: name,
+			Path: p,
+			Info: fi,
+		})
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return files, nil
+}
+
+// MatchAny returns whether the slash-separated name matches any of the patterns.
+// Patterns may contain `path.Match` wildcards and `**`, which matches any number of path elements.
+func MatchAny(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		if Match(pattern, name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Match returns whether the slash-separated name matches the pattern.
+// The pattern may contain `path.Match` wildcards and `**`, which matches any number of path elements.
+func Match(pattern, name string) bool {
+	return match(strings.Split(path.Clean(pattern), "/"), strings.Split(name, "/"))
+}
+
+func match(pattern, name []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			for i := 0; i <= len(name); i++ {
+				if match(pattern[1:], name[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+
+		if len(name) == 0 {
+			return false
+		}
+
+		if ok, err := path.Match(pattern[0], name[0]); err != nil || !ok {
+			return false
+		}
+
+		pattern, name = pattern[1:], name[1:]
+	}
+
+	return len(name) == 0
+}
@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fileset
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func TestMatchAny(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		patterns []string
+		name     string
+		expected bool
+	}{
+		"all": {
+			patterns: []string{"**"},
+			name:     "a/b/c.py",
+			expected: true,
+		},
+		"top-level only": {
+			patterns: []string{"*.py"},
+			name:     "a/b.py",
+			expected: false,
+		},
+		"any depth": {
+			patterns: []string{"**/*.py"},
+			name:     "a/b/c.py",
+			expected: true,
+		},
+		"any depth top-level": {
+			patterns: []string{"**/*.py"},
+			name:     "c.py",
+			expected: true,
+		},
+		"directory": {
+			patterns: []string{"tests"},
+			name:     "tests",
+			expected: true,
+		},
+		"directory contents": {
+			patterns: []string{"tests/**"},
+			name:     "tests/unit/test_a.py",
+			expected: true,
+		},
+		"no match": {
+			patterns: []string{"*.js", "lib/**"},
+			name:     "src/a.py",
+			expected: false,
+		},
+		"trailing slash": {
+			patterns: []string{"node_modules/"},
+			name:     "node_modules",
+			expected: true,
+		},
+		"no patterns": {
+			name:     "a.py",
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := MatchAny(testCase.patterns, testCase.name), testCase.expected; got != want {
+				t.Errorf("MatchAny(%q, %q) = %t, want %t", testCase.patterns, testCase.name, got, want)
+			}
+		})
+	}
+}
+
+func TestWalk(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	for _, name := range []string{
+		"index.html",
+		"css/site.css",
+		"js/app.js",
+		"drafts/post.html",
+		"drafts/img/a.png",
+	} {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, []byte(name), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	testCases := map[string]struct {
+		includes []string
+		excludes []string
+		expected []string
+	}{
+		"all": {
+			expected: []string{"css/site.css", "drafts/img/a.png", "drafts/post.html", "index.html", "js/app.js"},
+		},
+		"includes": {
+			includes: []string{"**/*.html"},
+			expected: []string{"drafts/post.html", "index.html"},
+		},
+		"excluded directory": {
+			excludes: []string{"drafts"},
+			expected: []string{"css/site.css", "index.html", "js/app.js"},
+		},
+		"includes and excludes": {
+			includes: []string{"**/*.html", "**/*.png"},
+			excludes: []string{"**/img"},
+			expected: []string{"drafts/post.html", "index.html"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			files, err := Walk(dir, testCase.includes, testCase.excludes)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var got []string
+			for _, v := range files {
+				got = append(got, v.Name)
+			}
+
+			if want := testCase.expected; !slices.Equal(got, want) {
+				t.Errorf("Walk(%q, %q) = %q, want %q", testCase.includes, testCase.excludes, got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,25 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// SendActionProgress reports an action invocation progress event to Terraform.
+func SendActionProgress(ctx context.Context, response *action.InvokeResponse, format string, a ...any) {
+	message := fmt.Sprintf(format, a...)
+
+	tflog.Info(ctx, message)
+
+	if response.SendProgress != nil {
+		response.SendProgress(action.InvokeProgressEvent{
+			Message: message,
+		})
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+)
+
+func TestSendActionProgress(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	var got []string
+	response := action.InvokeResponse{
+		SendProgress: func(event action.InvokeProgressEvent) {
+			got = append(got, event.Message)
+		},
+	}
+
+	SendActionProgress(ctx, &response, "Starting %s (%s)", "Thing", "id-1")
+	SendActionProgress(ctx, &response, "Done")
+
+	if diff := cmp.Diff(got, []string{"Starting Thing (id-1)", "Done"}); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestSendActionProgress_noSendProgress(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	var response action.InvokeResponse
+
+	// Must not panic when Terraform has not provided a progress callback.
+	SendActionProgress(ctx, &response, "Starting %s", "Thing")
+}
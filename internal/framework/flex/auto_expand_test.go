@@ -1176,6 +1176,23 @@ func TestExpandOptions(t *testing.T) {
 				Tags: map[string]string{"foo": "bar"},
 			},
 		},
+		{
+			TestName: "ignore appended custom field",
+			Options: []AutoFlexOptionsFunc{
+				WithIgnoredFieldNamesAppend("Field1"),
+			},
+			Source: &tf01{
+				Field1: types.BoolValue(true),
+				Tags: fwtypes.NewMapValueOfMust[types.String](
+					ctx,
+					map[string]attr.Value{
+						"foo": types.StringValue("bar"),
+					},
+				),
+			},
+			Target:     &aws01{},
+			WantTarget: &aws01{},
+		},
 	}
 	runAutoExpandTestCases(ctx, t, testCases)
 }
@@ -70,6 +70,16 @@ var (
 // AutoFlexOptionsFunc is a type alias for an autoFlexer functional option.
 type AutoFlexOptionsFunc func(*AutoFlexOptions)
 
+// WithIgnoredFieldNamesAppend appends the specified field names to the list of ignored field names.
+// Use this for fields, such as those of Smithy union types, that are expanded or flattened manually.
+func WithIgnoredFieldNamesAppend(fieldNames ...string) AutoFlexOptionsFunc {
+	return func(o *AutoFlexOptions) {
+		for _, fieldName := range fieldNames {
+			o.AddIgnoredField(fieldName)
+		}
+	}
+}
+
 // autoFlexConvert converts `from` to `to` using the specified auto-flexer.
 func autoFlexConvert(ctx context.Context, from, to any, flexer autoFlexer) diag.Diagnostics {
 	var diags diag.Diagnostics
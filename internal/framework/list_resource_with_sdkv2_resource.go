@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+
+	ctymsgpack "github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	sdkdiag "github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// ListResourceWithSDKv2Resource is a structure to be embedded within a ListResource that lists the objects managed by a Plugin SDK resource.
+// The managed resource's schema and identity schema are used for list results.
+type ListResourceWithSDKv2Resource struct {
+	withMeta
+	resource *schema.Resource
+}
+
+// Configure enables provider-level data or clients to be set in the
+// provider-defined ListResource type.
+func (l *ListResourceWithSDKv2Resource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
+		l.meta = v
+	}
+}
+
+// SetResourceSchema sets the Plugin SDK resource whose objects are listed.
+// The resource must have opted in to resource identity.
+func (l *ListResourceWithSDKv2Resource) SetResourceSchema(r *schema.Resource) {
+	l.resource = r
+}
+
+// RawV5Schemas returns the managed resource's schema and identity schema.
+func (l *ListResourceWithSDKv2Resource) RawV5Schemas(ctx context.Context, _ list.RawV5SchemaRequest, response *list.RawV5SchemaResponse) {
+	response.ProtoV5Schema = l.resource.ProtoSchema(ctx)()
+	if v := l.resource.ProtoIdentitySchema(ctx); v != nil {
+		response.ProtoV5IdentitySchema = v()
+	}
+}
+
+// ResourceData returns a new, empty ResourceData for the managed resource.
+func (l *ListResourceWithSDKv2Resource) ResourceData() *schema.ResourceData {
+	return l.resource.Data(&terraform.InstanceState{})
+}
+
+// SetResult sets a list result's identity and, if includeResource is set, its resource state from the specified ResourceData.
+// The ResourceData must have its ID and any natural key attributes set.
+// The resource state is obtained by calling the managed resource's Read function. If the object no longer exists the ResourceData's ID is cleared.
+func (l *ListResourceWithSDKv2Resource) SetResult(ctx context.Context, includeResource bool, d *schema.ResourceData, result *list.ListResult) {
+	if includeResource {
+		// The per-resource Region selects the Region in which the object is read.
+		if _, ok := l.resource.SchemaMap()[names.AttrRegion]; ok {
+			if err := d.Set(names.AttrRegion, l.Meta().Region(ctx)); err != nil {
+				result.Diagnostics.AddError("setting "+names.AttrRegion, err.Error())
+				return
+			}
+		}
+
+		var diags sdkdiag.Diagnostics
+		switch r := l.resource; {
+		case r.ReadWithoutTimeout != nil:
+			diags = r.ReadWithoutTimeout(ctx, d, l.Meta())
+		case r.ReadContext != nil:
+			diags = r.ReadContext(ctx, d, l.Meta())
+		}
+
+		for _, v := range diags {
+			if v.Severity == sdkdiag.Error {
+				result.Diagnostics.AddError(v.Summary, v.Detail)
+			} else {
+				result.Diagnostics.AddWarning(v.Summary, v.Detail)
+			}
+		}
+		if result.Diagnostics.HasError() {
+			return
+		}
+
+		// Removed from state.
+		if d.Id() == "" {
+			return
+		}
+
+		ty := l.resource.CoreConfigSchema().ImpliedType()
+		v, err := d.State().AttrsAsObjectValue(ty)
+		if err != nil {
+			result.Diagnostics.AddError("converting resource state", err.Error())
+			return
+		}
+
+		b, err := ctymsgpack.Marshal(v, ty)
+		if err != nil {
+			result.Diagnostics.AddError("encoding resource state", err.Error())
+			return
+		}
+
+		raw, err := tftypes.ValueFromMsgPack(b, l.resource.ProtoSchema(ctx)().ValueType())
+		if err != nil {
+			result.Diagnostics.AddError("decoding resource state", err.Error())
+			return
+		}
+
+		result.Resource.Raw = raw
+	}
+
+	for attr := range l.resource.Identity.SchemaMap() {
+		var v string
+		switch attr {
+		case names.AttrAccountID:
+			v = l.Meta().AccountID
+		case names.AttrRegion:
+			v = l.Meta().Region(ctx)
+		case names.AttrID:
+			v = d.Id()
+		default:
+			v, _ = d.Get(attr).(string)
+		}
+
+		result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root(attr), v)...)
+	}
+}
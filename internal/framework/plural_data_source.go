@@ -0,0 +1,247 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// PluralDataSource is a structure to be embedded within a plural data source.
+// A plural data source finds the objects of a type that match a name regular expression, tags and service-specific filters,
+// and returns their IDs, ARNs and summaries of type S.
+type PluralDataSource[S any] struct {
+	DataSourceWithConfigure
+}
+
+// PluralDataSourceModel is a structure to be embedded within a plural data source's model.
+// The data source's model adds its service-specific filter attributes.
+type PluralDataSourceModel[S any] struct {
+	ARNs      fwtypes.ListValueOf[types.String]  `tfsdk:"arns"`
+	ID        types.String                       `tfsdk:"id"`
+	IDs       fwtypes.ListValueOf[types.String]  `tfsdk:"ids"`
+	NameRegex fwtypes.Regexp                     `tfsdk:"name_regex"`
+	Summaries fwtypes.ListNestedObjectValueOf[S] `tfsdk:"summaries"`
+	Tags      types.Map                          `tfsdk:"tags"`
+}
+
+// PluralDataSourceObject is an object found by a plural data source.
+type PluralDataSourceObject struct {
+	ARN string
+	ID  string
+	// Names are the object's names. The object matches the name regular expression if any of its names do.
+	Names []string
+	// Source is flattened into the object's summary.
+	Source any
+	// Tags returns the object's tags. It is only called when filtering by tags.
+	Tags func(context.Context) (tftags.KeyValueTags, error)
+}
+
+// PluralDataSourceLister calls fn for each object that matches a plural data source's service-specific filters, until fn returns false.
+// Listers are typically implemented using the paginated functions created by the listpages generator.
+type PluralDataSourceLister func(ctx context.Context, fn func(PluralDataSourceObject) bool) error
+
+// PluralSchema returns the schema of a plural data source with the specified service-specific filter attributes.
+// The summaries' attributes are derived from the summary type, which must only have the field types supported by computedAttributes.
+func (d *PluralDataSource[S]) PluralSchema(ctx context.Context, attributes map[string]schema.Attribute) (schema.Schema, diag.Diagnostics) {
+	attrTypes, diags := fwtypes.AttributeTypes[S](ctx)
+	if diags.HasError() {
+		return schema.Schema{}, diags
+	}
+
+	summaryAttributes, err := computedAttributes(attrTypes)
+	if err != nil {
+		diags.AddError("creating plural data source schema", err.Error())
+
+		return schema.Schema{}, diags
+	}
+
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARNs: schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			names.AttrID: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrIDs: schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"name_regex": schema.StringAttribute{
+				CustomType: fwtypes.RegexpType,
+				Optional:   true,
+			},
+			"summaries": schema.ListNestedAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[S](ctx),
+				Computed:   true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: summaryAttributes,
+				},
+			},
+			names.AttrTags: schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+		},
+	}
+
+	for k, v := range attributes {
+		s.Attributes[k] = v
+	}
+
+	return s, diags
+}
+
+// ReadObjects sets the plural data source's computed attributes from the objects returned by list that match the name regular expression and tags.
+// The typeName, for example "ECS Services", is used in error messages.
+func (d *PluralDataSource[S]) ReadObjects(ctx context.Context, data *PluralDataSourceModel[S], typeName string, list PluralDataSourceLister) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	nameRegex := data.NameRegex.ValueRegexp()
+	tags := tftags.New(ctx, data.Tags)
+
+	var objects []PluralDataSourceObject
+	var errTags error
+	err := list(ctx, func(v PluralDataSourceObject) bool {
+		if nameRegex != nil && !matchAnyName(nameRegex.MatchString, v.Names) {
+			return true
+		}
+
+		if len(tags) > 0 {
+			if v.Tags == nil {
+				return true
+			}
+
+			objectTags, err := v.Tags(ctx)
+			if err != nil {
+				errTags = err
+				return false
+			}
+
+			if !objectTags.ContainsAll(tags) {
+				return true
+			}
+		}
+
+		objects = append(objects, v)
+
+		return true
+	})
+
+	if err == nil {
+		err = errTags
+	}
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("reading %s", typeName), err.Error())
+
+		return diags
+	}
+
+	arns := make([]string, 0, len(objects))
+	ids := make([]string, 0, len(objects))
+	summaries := make([]*S, 0, len(objects))
+	for _, v := range objects {
+		arns = append(arns, v.ARN)
+		ids = append(ids, v.ID)
+
+		var summary S
+		diags.Append(flex.Flatten(ctx, v.Source, &summary)...)
+		if diags.HasError() {
+			return diags
+		}
+		summaries = append(summaries, &summary)
+	}
+
+	data.ID = flex.StringValueToFramework(ctx, d.Meta().Region(ctx))
+	// No objects is an empty, not a null, list.
+	data.ARNs = fwtypes.ListValueOf[types.String]{ListValue: flex.FlattenFrameworkStringValueListLegacy(ctx, arns)}
+	data.IDs = fwtypes.ListValueOf[types.String]{ListValue: flex.FlattenFrameworkStringValueListLegacy(ctx, ids)}
+	data.Summaries = fwtypes.NewListNestedObjectValueOfSliceMust(ctx, summaries)
+
+	return diags
+}
+
+func matchAnyName(match func(string) bool, names []string) bool {
+	for _, v := range names {
+		if match(v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// computedAttributes returns computed schema attributes for the specified attribute types.
+// Only primitive types and lists, sets and maps of primitive types are supported.
+func computedAttributes(attrTypes map[string]attr.Type) (map[string]schema.Attribute, error) {
+	attributes := make(map[string]schema.Attribute, len(attrTypes))
+
+	for k, v := range attrTypes {
+		switch t := v.(type) {
+		case basetypes.StringTypable:
+			attributes[k] = schema.StringAttribute{CustomType: t, Computed: true}
+		case basetypes.BoolTypable:
+			attributes[k] = schema.BoolAttribute{CustomType: t, Computed: true}
+		case basetypes.Int64Typable:
+			attributes[k] = schema.Int64Attribute{CustomType: t, Computed: true}
+		case basetypes.Int32Typable:
+			attributes[k] = schema.Int32Attribute{CustomType: t, Computed: true}
+		case basetypes.Float64Typable:
+			attributes[k] = schema.Float64Attribute{CustomType: t, Computed: true}
+		case basetypes.ListTypable:
+			elementType, err := primitiveElementType(k, t)
+			if err != nil {
+				return nil, err
+			}
+			attributes[k] = schema.ListAttribute{CustomType: t, ElementType: elementType, Computed: true}
+		case basetypes.SetTypable:
+			elementType, err := primitiveElementType(k, t)
+			if err != nil {
+				return nil, err
+			}
+			attributes[k] = schema.SetAttribute{CustomType: t, ElementType: elementType, Computed: true}
+		case basetypes.MapTypable:
+			elementType, err := primitiveElementType(k, t)
+			if err != nil {
+				return nil, err
+			}
+			attributes[k] = schema.MapAttribute{CustomType: t, ElementType: elementType, Computed: true}
+		default:
+			return nil, fmt.Errorf("unsupported summary attribute type: %s (%T)", k, v)
+		}
+	}
+
+	return attributes, nil
+}
+
+// primitiveElementType returns the element type of the specified collection type.
+// Only collections of primitive types are supported.
+func primitiveElementType(name string, t attr.Type) (attr.Type, error) {
+	v, ok := t.(attr.TypeWithElementType)
+	if !ok {
+		return nil, fmt.Errorf("unsupported summary attribute type: %s (%T)", name, t)
+	}
+
+	switch elementType := v.ElementType(); elementType.(type) {
+	case basetypes.StringTypable, basetypes.BoolTypable, basetypes.Int64Typable, basetypes.Int32Typable, basetypes.Float64Typable:
+		return elementType, nil
+	default:
+		return nil, fmt.Errorf("unsupported summary attribute element type: %s (%T)", name, elementType)
+	}
+}
@@ -0,0 +1,324 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+type testPluralSummaryModel struct {
+	Aliases fwtypes.ListValueOf[types.String] `tfsdk:"aliases"`
+	Name    types.String                      `tfsdk:"name"`
+	Size    types.Int64                       `tfsdk:"size"`
+}
+
+type testPluralNestedSummaryModel struct {
+	Name   types.String                                            `tfsdk:"name"`
+	Nested fwtypes.ListNestedObjectValueOf[testPluralSummaryModel] `tfsdk:"nested"`
+}
+
+type testPluralSource struct {
+	Aliases []string
+	Name    *string
+	Size    *int64
+}
+
+func TestComputedAttributes(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attrTypes map[string]attr.Type
+		expectErr bool
+	}{
+		"primitives": {
+			attrTypes: map[string]attr.Type{
+				"bool":    types.BoolType,
+				"float64": types.Float64Type,
+				"int32":   types.Int32Type,
+				"int64":   types.Int64Type,
+				"string":  types.StringType,
+				"arn":     fwtypes.ARNType,
+			},
+		},
+		"collections": {
+			attrTypes: map[string]attr.Type{
+				"list": fwtypes.ListOfStringType,
+				"map":  types.MapType{ElemType: types.Int64Type},
+				"set":  types.SetType{ElemType: types.BoolType},
+			},
+		},
+		"object": {
+			attrTypes: map[string]attr.Type{
+				"object": types.ObjectType{AttrTypes: map[string]attr.Type{"name": types.StringType}},
+			},
+			expectErr: true,
+		},
+		"list of objects": {
+			attrTypes: map[string]attr.Type{
+				"list": types.ListType{ElemType: types.ObjectType{AttrTypes: map[string]attr.Type{"name": types.StringType}}},
+			},
+			expectErr: true,
+		},
+		"list of lists": {
+			attrTypes: map[string]attr.Type{
+				"list": types.ListType{ElemType: types.ListType{ElemType: types.StringType}},
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := computedAttributes(testCase.attrTypes)
+
+			if testCase.expectErr {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := len(got), len(testCase.attrTypes); got != want {
+				t.Errorf("computedAttributes() returned %d attributes, want %d", got, want)
+			}
+
+			for k, v := range got {
+				if !v.IsComputed() || v.IsOptional() || v.IsRequired() {
+					t.Errorf("attribute %s is not computed only", k)
+				}
+				if got, want := v.GetType(), testCase.attrTypes[k]; !got.Equal(want) {
+					t.Errorf("attribute %s type = %s, want %s", k, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestPluralSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("supported summary", func(t *testing.T) {
+		t.Parallel()
+
+		var d PluralDataSource[testPluralSummaryModel]
+		s, diags := d.PluralSchema(ctx, nil)
+
+		if diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+
+		for _, k := range []string{names.AttrARNs, names.AttrID, names.AttrIDs, "name_regex", "summaries", names.AttrTags} {
+			if _, ok := s.Attributes[k]; !ok {
+				t.Errorf("attribute %s not found", k)
+			}
+		}
+	})
+
+	t.Run("unsupported summary", func(t *testing.T) {
+		t.Parallel()
+
+		var d PluralDataSource[testPluralNestedSummaryModel]
+		_, diags := d.PluralSchema(ctx, nil)
+
+		if !diags.HasError() {
+			t.Fatal("expected error, got none")
+		}
+	})
+}
+
+func TestReadObjects(t *testing.T) {
+	t.Parallel()
+
+	objectTags := map[string]map[string]string{
+		"alpha": {"env": "test", "team": "a"},
+		"beta":  {"env": "prod"},
+		"gamma": {"env": "test", "team": "b"},
+	}
+	objects := make([]PluralDataSourceObject, 0, len(objectTags))
+	for _, v := range []string{"alpha", "beta", "gamma"} {
+		objects = append(objects, PluralDataSourceObject{
+			ARN:    v + "-arn",
+			ID:     v,
+			Names:  []string{v, v + "-alias"},
+			Source: &testPluralSource{Aliases: []string{v + "-alias"}, Name: aws.String(v), Size: aws.Int64(int64(len(v)))},
+			Tags: func(context.Context) (tftags.KeyValueTags, error) {
+				return tftags.New(context.Background(), objectTags[v]), nil
+			},
+		})
+	}
+
+	list := func(ctx context.Context, fn func(PluralDataSourceObject) bool) error {
+		for _, v := range objects {
+			if !fn(v) {
+				break
+			}
+		}
+		return nil
+	}
+
+	testCases := map[string]struct {
+		nameRegex   string
+		tags        map[string]string
+		list        PluralDataSourceLister
+		expectedIDs []string
+		expectErr   bool
+	}{
+		"no filters": {
+			list:        list,
+			expectedIDs: []string{"alpha", "beta", "gamma"},
+		},
+		"no objects": {
+			list: func(context.Context, func(PluralDataSourceObject) bool) error {
+				return nil
+			},
+			expectedIDs: []string{},
+		},
+		"name_regex": {
+			nameRegex:   "^(alpha|gamma)$",
+			list:        list,
+			expectedIDs: []string{"alpha", "gamma"},
+		},
+		"name_regex any name": {
+			nameRegex:   "^beta-alias$",
+			list:        list,
+			expectedIDs: []string{"beta"},
+		},
+		"tags": {
+			tags:        map[string]string{"env": "test"},
+			list:        list,
+			expectedIDs: []string{"alpha", "gamma"},
+		},
+		"name_regex and tags": {
+			nameRegex:   "^g",
+			tags:        map[string]string{"env": "test"},
+			list:        list,
+			expectedIDs: []string{"gamma"},
+		},
+		"tags no match": {
+			tags:        map[string]string{"env": "test", "team": "c"},
+			list:        list,
+			expectedIDs: []string{},
+		},
+		"tags not supported": {
+			tags: map[string]string{"env": "test"},
+			list: func(ctx context.Context, fn func(PluralDataSourceObject) bool) error {
+				fn(PluralDataSourceObject{ID: "delta", Source: &testPluralSource{}})
+				return nil
+			},
+			expectedIDs: []string{},
+		},
+		"tags error": {
+			tags: map[string]string{"env": "test"},
+			list: func(ctx context.Context, fn func(PluralDataSourceObject) bool) error {
+				fn(PluralDataSourceObject{
+					ID:     "delta",
+					Source: &testPluralSource{},
+					Tags: func(context.Context) (tftags.KeyValueTags, error) {
+						return nil, errors.New("listing tags")
+					},
+				})
+				return nil
+			},
+			expectErr: true,
+		},
+		"list error": {
+			list: func(context.Context, func(PluralDataSourceObject) bool) error {
+				return errors.New("listing")
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := conns.NewDataSourceContext(context.Background(), "test", "Test")
+			if v, ok := conns.FromContext(ctx); ok {
+				v.Region = names.USWest2RegionID
+			}
+
+			var d PluralDataSource[testPluralSummaryModel]
+			d.meta = &conns.AWSClient{}
+
+			data := PluralDataSourceModel[testPluralSummaryModel]{
+				NameRegex: fwtypes.RegexpNull(),
+				Tags:      types.MapNull(types.StringType),
+			}
+			if testCase.nameRegex != "" {
+				data.NameRegex = fwtypes.RegexpValue(testCase.nameRegex)
+			}
+			if testCase.tags != nil {
+				elements := make(map[string]attr.Value, len(testCase.tags))
+				for k, v := range testCase.tags {
+					elements[k] = types.StringValue(v)
+				}
+				data.Tags = types.MapValueMust(types.StringType, elements)
+			}
+
+			diags := d.ReadObjects(ctx, &data, "Test Objects", testCase.list)
+
+			if testCase.expectErr {
+				if !diags.HasError() {
+					t.Fatal("expected error, got none")
+				}
+				return
+			}
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if got, want := data.ID.ValueString(), names.USWest2RegionID; got != want {
+				t.Errorf("id = %s, want %s", got, want)
+			}
+
+			var ids []string
+			for _, v := range data.IDs.Elements() {
+				ids = append(ids, v.(types.String).ValueString())
+			}
+			if got, want := ids, testCase.expectedIDs; !slices.Equal(got, want) {
+				t.Errorf("ids = %v, want %v", got, want)
+			}
+			if data.IDs.IsNull() || data.ARNs.IsNull() || data.Summaries.IsNull() {
+				t.Error("expected non-null ids, arns and summaries")
+			}
+
+			summaries, diags := data.Summaries.ToSlice(ctx)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if got, want := len(summaries), len(testCase.expectedIDs); got != want {
+				t.Fatalf("%d summaries, want %d", got, want)
+			}
+			for i, v := range summaries {
+				if got, want := v.Name.ValueString(), testCase.expectedIDs[i]; got != want {
+					t.Errorf("summaries[%d].name = %s, want %s", i, got, want)
+				}
+				if got, want := v.Size.ValueInt64(), int64(len(testCase.expectedIDs[i])); got != want {
+					t.Errorf("summaries[%d].size = %d, want %d", i, got, want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retrypolicy"
+)
+
+const (
+	retryPolicyBlockName = "retry_policy"
+)
+
+// RetryPolicyBlock returns the schema for the special `retry_policy` nested block.
+// A resource opts in to a practitioner configurable retry policy by adding the block to its schema
+// and a `retry_policy` field of type `fwtypes.ListNestedObjectValueOf[framework.RetryPolicyModel]` to its model.
+func RetryPolicyBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[RetryPolicyModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"base_delay": schema.StringAttribute{
+					CustomType: fwtypes.DurationType,
+					Optional:   true,
+				},
+				"jitter": schema.StringAttribute{
+					CustomType: fwtypes.DurationType,
+					Optional:   true,
+				},
+				"max_elapsed": schema.StringAttribute{
+					CustomType: fwtypes.DurationType,
+					Optional:   true,
+				},
+			},
+		},
+	}
+}
+
+type RetryPolicyModel struct {
+	BaseDelay  fwtypes.Duration `tfsdk:"base_delay"`
+	Jitter     fwtypes.Duration `tfsdk:"jitter"`
+	MaxElapsed fwtypes.Duration `tfsdk:"max_elapsed"`
+}
+
+// HasRetryPolicyBlock returns whether the specified resource schema contains the `retry_policy` nested block.
+// Resources that define an unrelated `retry_policy` block are not matched.
+func HasRetryPolicyBlock(s schema.Schema) bool {
+	v, ok := s.Blocks[retryPolicyBlockName].(schema.ListNestedBlock)
+	if !ok {
+		return false
+	}
+
+	return slices.Equal(slices.Sorted(maps.Keys(v.NestedObject.Attributes)), slices.Sorted(maps.Keys(fwtypes.AttributeTypesMust[RetryPolicyModel](context.Background()))))
+}
+
+// GetRetryPolicy returns any configured retry policy.
+func GetRetryPolicy(ctx context.Context, getAttribute func(context.Context, path.Path, any) diag.Diagnostics) (retrypolicy.RetryPolicy, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var v fwtypes.ListNestedObjectValueOf[RetryPolicyModel]
+	diags.Append(getAttribute(ctx, path.Root(retryPolicyBlockName), &v)...)
+	if diags.HasError() {
+		return retrypolicy.RetryPolicy{}, false, diags
+	}
+
+	data, d := v.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || data == nil {
+		return retrypolicy.RetryPolicy{}, false, diags
+	}
+
+	return retrypolicy.RetryPolicy{
+		BaseDelay:  data.BaseDelay.ValueDuration(),
+		Jitter:     data.Jitter.ValueDuration(),
+		MaxElapsed: data.MaxElapsed.ValueDuration(),
+	}, true, diags
+}
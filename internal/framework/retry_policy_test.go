@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/retrypolicy"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestHasRetryPolicyBlock(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		schema   schema.Schema
+		expected bool
+	}{
+		"no retry_policy": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					names.AttrName: schema.StringAttribute{
+						Required: true,
+					},
+				},
+			},
+		},
+		"retry_policy": {
+			schema: schema.Schema{
+				Blocks: map[string]schema.Block{
+					"retry_policy": RetryPolicyBlock(ctx),
+				},
+			},
+			expected: true,
+		},
+		"unrelated retry_policy": {
+			schema: schema.Schema{
+				Blocks: map[string]schema.Block{
+					"retry_policy": schema.ListNestedBlock{
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"maximum_retry_attempts": schema.Int64Attribute{
+									Optional: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := HasRetryPolicyBlock(testCase.schema), testCase.expected; got != want {
+				t.Errorf("HasRetryPolicyBlock() = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestGetRetryPolicy(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	s := schema.Schema{
+		Blocks: map[string]schema.Block{
+			"retry_policy": RetryPolicyBlock(ctx),
+		},
+	}
+	objectType := s.Type().TerraformType(ctx).(tftypes.Object)
+	listType := objectType.AttributeTypes["retry_policy"].(tftypes.List)
+	elementType := listType.ElementType.(tftypes.Object)
+
+	testCases := map[string]struct {
+		value      tftypes.Value
+		expected   retrypolicy.RetryPolicy
+		expectedOK bool
+	}{
+		"no retry_policy": {
+			value: tftypes.NewValue(objectType, map[string]tftypes.Value{
+				"retry_policy": tftypes.NewValue(listType, []tftypes.Value{}),
+			}),
+		},
+		"retry_policy": {
+			value: tftypes.NewValue(objectType, map[string]tftypes.Value{
+				"retry_policy": tftypes.NewValue(listType, []tftypes.Value{
+					tftypes.NewValue(elementType, map[string]tftypes.Value{
+						"base_delay":  tftypes.NewValue(tftypes.String, "5s"),
+						"jitter":      tftypes.NewValue(tftypes.String, nil),
+						"max_elapsed": tftypes.NewValue(tftypes.String, "10m"),
+					}),
+				}),
+			}),
+			expected: retrypolicy.RetryPolicy{
+				BaseDelay:  5 * time.Second,
+				MaxElapsed: 10 * time.Minute,
+			},
+			expectedOK: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			config := tfsdk.Config{
+				Schema: s,
+				Raw:    testCase.value,
+			}
+			got, ok, diags := GetRetryPolicy(ctx, config.GetAttribute)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if ok != testCase.expectedOK {
+				t.Errorf("GetRetryPolicy() ok = %t, want %t", ok, testCase.expectedOK)
+			}
+			if got != testCase.expected {
+				t.Errorf("GetRetryPolicy() = %v, want %v", got, testCase.expected)
+			}
+		})
+	}
+}
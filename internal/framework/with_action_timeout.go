@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// WithActionTimeout is intended to be embedded in actions which use the `timeout` argument.
+// The argument is the maximum number of seconds to wait for the action to complete.
+type WithActionTimeout struct {
+	defaultTimeout time.Duration
+}
+
+// SetDefaultTimeout sets the action's default timeout value.
+func (w *WithActionTimeout) SetDefaultTimeout(timeout time.Duration) {
+	w.defaultTimeout = timeout
+}
+
+// Timeout returns any configured timeout value or the default value.
+func (w *WithActionTimeout) Timeout(timeout types.Int64) time.Duration {
+	if timeout.IsNull() || timeout.IsUnknown() {
+		return w.defaultTimeout
+	}
+
+	return time.Duration(timeout.ValueInt64()) * time.Second
+}
+
+// ActionTimeoutAttribute returns the schema for an action's `timeout` argument.
+func ActionTimeoutAttribute() schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Optional: true,
+		Validators: []validator.Int64{
+			int64validator.AtLeast(1),
+		},
+		Description: "Maximum number of seconds to wait for the action to complete.",
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestWithActionTimeoutTimeout(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		timeout  types.Int64
+		expected time.Duration
+	}{
+		"null": {
+			timeout:  types.Int64Null(),
+			expected: 10 * time.Minute,
+		},
+		"unknown": {
+			timeout:  types.Int64Unknown(),
+			expected: 10 * time.Minute,
+		},
+		"configured": {
+			timeout:  types.Int64Value(90),
+			expected: 90 * time.Second,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var w WithActionTimeout
+			w.SetDefaultTimeout(10 * time.Minute)
+
+			if got, want := w.Timeout(testCase.timeout), testCase.expected; got != want {
+				t.Errorf("Timeout() = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestActionTimeoutAttribute(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	attribute := ActionTimeoutAttribute()
+
+	if !attribute.Optional {
+		t.Error("expected timeout attribute to be Optional")
+	}
+
+	testCases := map[string]struct {
+		value       types.Int64
+		expectError bool
+	}{
+		"null": {
+			value: types.Int64Null(),
+		},
+		"zero": {
+			value:       types.Int64Value(0),
+			expectError: true,
+		},
+		"negative": {
+			value:       types.Int64Value(-1),
+			expectError: true,
+		},
+		"positive": {
+			value: types.Int64Value(1),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			request := validator.Int64Request{
+				Path:        path.Root("timeout"),
+				ConfigValue: testCase.value,
+			}
+			var response validator.Int64Response
+
+			for _, v := range attribute.Int64Validators() {
+				v.ValidateInt64(ctx, request, &response)
+			}
+
+			if got, want := response.Diagnostics.HasError(), testCase.expectError; got != want {
+				t.Errorf("HasError() = %t, want %t: %v", got, want, response.Diagnostics)
+			}
+		})
+	}
+}
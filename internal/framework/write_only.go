@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// WriteOnlyStringAttribute returns the schema for an optional, sensitive write-only string attribute.
+// Write-only attribute values are never persisted in plan or state so the accompanying `*_wo_version` attribute is required.
+func WriteOnlyStringAttribute(versionAttributeName string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:  true,
+		Sensitive: true,
+		WriteOnly: true,
+		Validators: []validator.String{
+			stringvalidator.AlsoRequires(path.MatchRoot(versionAttributeName)),
+		},
+	}
+}
+
+// WriteOnlyVersionAttribute returns the schema for the `*_wo_version` attribute that accompanies the specified write-only attribute.
+// Changing the version triggers an update of the write-only attribute's value.
+func WriteOnlyVersionAttribute(writeOnlyAttributeName string) schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Optional: true,
+		Validators: []validator.Int64{
+			int64validator.AlsoRequires(path.MatchRoot(writeOnlyAttributeName)),
+		},
+	}
+}
+
+// GetWriteOnlyStringValue returns the value of the write-only string attribute at the specified path.
+// Write-only attribute values are never persisted in plan or state so are read from configuration.
+func GetWriteOnlyStringValue(ctx context.Context, config tfsdk.Config, p path.Path) (types.String, diag.Diagnostics) {
+	var v types.String
+	diags := config.GetAttribute(ctx, p, &v)
+
+	return v, diags
+}
@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestWriteOnlyStringAttribute(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"password_wo":         WriteOnlyStringAttribute("password_wo_version"),
+			"password_wo_version": WriteOnlyVersionAttribute("password_wo"),
+		},
+	}
+	objectType := s.Type().TerraformType(ctx).(tftypes.Object)
+
+	testCases := map[string]struct {
+		passwordWO        tftypes.Value
+		passwordWOVersion tftypes.Value
+		expectError       bool
+	}{
+		"both": {
+			passwordWO:        tftypes.NewValue(tftypes.String, "secret"),
+			passwordWOVersion: tftypes.NewValue(tftypes.Number, 1),
+		},
+		"neither": {
+			passwordWO:        tftypes.NewValue(tftypes.String, nil),
+			passwordWOVersion: tftypes.NewValue(tftypes.Number, nil),
+		},
+		"no version": {
+			passwordWO:        tftypes.NewValue(tftypes.String, "secret"),
+			passwordWOVersion: tftypes.NewValue(tftypes.Number, nil),
+			expectError:       true,
+		},
+		"no value": {
+			passwordWO:        tftypes.NewValue(tftypes.String, nil),
+			passwordWOVersion: tftypes.NewValue(tftypes.Number, 1),
+			expectError:       true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			config := tfsdk.Config{
+				Schema: s,
+				Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+					"password_wo":         testCase.passwordWO,
+					"password_wo_version": testCase.passwordWOVersion,
+				}),
+			}
+
+			var passwordWO types.String
+			if diags := config.GetAttribute(ctx, path.Root("password_wo"), &passwordWO); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			var passwordWOVersion types.Int64
+			if diags := config.GetAttribute(ctx, path.Root("password_wo_version"), &passwordWOVersion); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			var stringResponse validator.StringResponse
+			for _, v := range s.Attributes["password_wo"].(schema.StringAttribute).StringValidators() {
+				v.ValidateString(ctx, validator.StringRequest{
+					Path:           path.Root("password_wo"),
+					PathExpression: path.MatchRoot("password_wo"),
+					Config:         config,
+					ConfigValue:    passwordWO,
+				}, &stringResponse)
+			}
+			var int64Response validator.Int64Response
+			for _, v := range s.Attributes["password_wo_version"].(schema.Int64Attribute).Int64Validators() {
+				v.ValidateInt64(ctx, validator.Int64Request{
+					Path:           path.Root("password_wo_version"),
+					PathExpression: path.MatchRoot("password_wo_version"),
+					Config:         config,
+					ConfigValue:    passwordWOVersion,
+				}, &int64Response)
+			}
+
+			if got, want := stringResponse.Diagnostics.HasError() || int64Response.Diagnostics.HasError(), testCase.expectError; got != want {
+				t.Errorf("HasError() = %t, want %t: %v %v", got, want, stringResponse.Diagnostics, int64Response.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestGetWriteOnlyStringValue(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"password_wo":         WriteOnlyStringAttribute("password_wo_version"),
+			"password_wo_version": WriteOnlyVersionAttribute("password_wo"),
+		},
+	}
+	config := tfsdk.Config{
+		Schema: s,
+		Raw: tftypes.NewValue(s.Type().TerraformType(ctx), map[string]tftypes.Value{
+			"password_wo":         tftypes.NewValue(tftypes.String, "secret"),
+			"password_wo_version": tftypes.NewValue(tftypes.Number, 1),
+		}),
+	}
+
+	got, diags := GetWriteOnlyStringValue(ctx, config, path.Root("password_wo"))
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if want := types.StringValue("secret"); !got.Equal(want) {
+		t.Errorf("GetWriteOnlyStringValue() = %s, want %s", got, want)
+	}
+}
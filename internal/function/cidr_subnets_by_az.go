@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = cidrSubnetsByAZFunction{}
+
+func NewCIDRSubnetsByAZFunction() function.Function {
+	return &cidrSubnetsByAZFunction{}
+}
+
+type cidrSubnetsByAZFunction struct{}
+
+func (f cidrSubnetsByAZFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_subnets_by_az"
+}
+
+func (f cidrSubnetsByAZFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "cidr_subnets_by_az Function",
+		MarkdownDescription: "Divides a CIDR block into consecutive subnets, one per Availability Zone. " +
+			"The result is a map of Availability Zone name to subnet CIDR block.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "cidr_block",
+				MarkdownDescription: "IPv4 or IPv6 CIDR block to divide",
+			},
+			function.ListParameter{
+				Name:                "availability_zones",
+				ElementType:         types.StringType,
+				MarkdownDescription: "Availability Zone names, in the order subnets are to be allocated",
+			},
+			function.Int64Parameter{
+				Name:                "newbits",
+				MarkdownDescription: "Number of additional prefix bits for each subnet",
+			},
+		},
+		Return: function.MapReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f cidrSubnetsByAZFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidrBlock string
+	var azs []string
+	var newbits int64
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cidrBlock, &azs, &newbits))
+	if resp.Error != nil {
+		return
+	}
+
+	prefix, err := netip.ParsePrefix(cidrBlock)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	if newbits < 1 || int(newbits)+prefix.Bits() > prefix.Addr().BitLen() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(2, fmt.Sprintf("newbits must be between 1 and %d", prefix.Addr().BitLen()-prefix.Bits())))
+		return
+	}
+
+	result := make(map[string]string, len(azs))
+	for i, az := range azs {
+		if _, ok := result[az]; ok {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, fmt.Sprintf("duplicate Availability Zone: %s", az)))
+			return
+		}
+
+		subnet, err := cidrSubnet(prefix, int(newbits), int64(i))
+		if err != nil {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+			return
+		}
+
+		result[az] = subnet.String()
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// cidrSubnet calculates the netnum'th subnet of prefix that has newbits additional prefix bits.
+func cidrSubnet(prefix netip.Prefix, newbits int, netnum int64) (netip.Prefix, error) {
+	prefix = prefix.Masked()
+	bitLen := prefix.Addr().BitLen()
+	newPrefixLen := prefix.Bits() + newbits
+
+	if maxNetnum := new(big.Int).Lsh(big.NewInt(1), uint(newbits)); big.NewInt(netnum).Cmp(maxNetnum) >= 0 {
+		return netip.Prefix{}, fmt.Errorf("%s does not have room for %d subnets of size /%d", prefix, netnum+1, newPrefixLen)
+	}
+
+	base := new(big.Int).SetBytes(prefix.Addr().AsSlice())
+	offset := new(big.Int).Lsh(big.NewInt(netnum), uint(bitLen-newPrefixLen))
+	b := new(big.Int).Or(base, offset).FillBytes(make([]byte, bitLen/8))
+
+	addr, ok := netip.AddrFromSlice(b)
+	if !ok {
+		return netip.Prefix{}, fmt.Errorf("invalid address: %v", b)
+	}
+
+	return netip.PrefixFrom(addr, newPrefixLen), nil
+}
@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestCIDRSubnetsByAZFunction_ipv4(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCIDRSubnetsByAZFunctionConfig("10.0.0.0/16", 8),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("a", "10.0.0.0/24"),
+					resource.TestCheckOutput("b", "10.0.1.0/24"),
+					resource.TestCheckOutput("c", "10.0.2.0/24"),
+				),
+			},
+		},
+	})
+}
+
+func TestCIDRSubnetsByAZFunction_ipv6(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCIDRSubnetsByAZFunctionConfig("2600:1f14:abc:de00::/56", 8),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("a", "2600:1f14:abc:de00::/64"),
+					resource.TestCheckOutput("b", "2600:1f14:abc:de01::/64"),
+					resource.TestCheckOutput("c", "2600:1f14:abc:de02::/64"),
+				),
+			},
+		},
+	})
+}
+
+func TestCIDRSubnetsByAZFunction_tooSmall(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testCIDRSubnetsByAZFunctionConfig("10.0.0.0/24", 1),
+				ExpectError: regexache.MustCompile(`does[\s\n]*not[\s\n]*have[\s\n]*room`),
+			},
+		},
+	})
+}
+
+func TestCIDRSubnetsByAZFunction_invalidCIDR(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testCIDRSubnetsByAZFunctionConfig("10.0.0.0", 8),
+				ExpectError: regexache.MustCompile(`no[\s\n]*'/'`),
+			},
+		},
+	})
+}
+
+func testCIDRSubnetsByAZFunctionConfig(cidrBlock string, newbits int) string {
+	return fmt.Sprintf(`
+locals {
+  subnets = provider::aws::cidr_subnets_by_az(%[1]q, ["us-west-2a", "us-west-2b", "us-west-2c"], %[2]d)
+}
+
+output "a" {
+  value = local.subnets["us-west-2a"]
+}
+
+output "b" {
+  value = local.subnets["us-west-2b"]
+}
+
+output "c" {
+  value = local.subnets["us-west-2c"]
+}
+`, cidrBlock, newbits)
+}
@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+var _ function.Function = policyNormalizeFunction{}
+
+func NewPolicyNormalizeFunction() function.Function {
+	return &policyNormalizeFunction{}
+}
+
+type policyNormalizeFunction struct{}
+
+func (f policyNormalizeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "policy_normalize"
+}
+
+func (f policyNormalizeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "policy_normalize Function",
+		MarkdownDescription: "Normalizes an IAM policy JSON document. Insignificant whitespace is removed, " +
+			"keys are sorted and the Version element is placed first. A single statement becomes a list, " +
+			"statements are sorted, and actions, resources, principals and condition values become a string " +
+			"if there is one value and a sorted list otherwise, so that equivalent policies produce identical strings.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "policy",
+				MarkdownDescription: "IAM policy JSON document",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f policyNormalizeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := verify.PolicyCanonicalize(arg)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
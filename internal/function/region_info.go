@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+var regionInfoResultAttrTypes = map[string]attr.Type{
+	"dns_suffix": types.StringType,
+	"opt_in":     types.BoolType,
+	"partition":  types.StringType,
+}
+
+var _ function.Function = regionInfoFunction{}
+
+func NewRegionInfoFunction() function.Function {
+	return &regionInfoFunction{}
+}
+
+type regionInfoFunction struct{}
+
+func (f regionInfoFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "region_info"
+}
+
+func (f regionInfoFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "region_info Function",
+		MarkdownDescription: "Returns information about a region, including its partition and DNS suffix",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "region",
+				MarkdownDescription: "Region code",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: regionInfoResultAttrTypes,
+		},
+	}
+}
+
+func (f regionInfoFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	if arg == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, "region must not be empty"))
+		return
+	}
+
+	partition := names.PartitionForRegion(arg)
+	value := map[string]attr.Value{
+		"dns_suffix": types.StringValue(names.DNSSuffixForPartition(partition)),
+		"opt_in":     types.BoolValue(names.IsOptInRegion(arg)),
+		"partition":  types.StringValue(partition),
+	}
+
+	result, d := types.ObjectValue(regionInfoResultAttrTypes, value)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestRegionInfoFunction_known(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testRegionInfoFunctionConfig("cn-northwest-1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("dns_suffix", "amazonaws.com.cn"),
+					resource.TestCheckOutput("opt_in", "false"),
+					resource.TestCheckOutput("partition", "aws-cn"),
+				),
+			},
+		},
+	})
+}
+
+func TestRegionInfoFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testRegionInfoFunctionConfig(""),
+				ExpectError: regexache.MustCompile(`region[\s\n]*must[\s\n]*not[\s\n]*be[\s\n]*empty`),
+			},
+		},
+	})
+}
+
+func testRegionInfoFunctionConfig(arg string) string {
+	return fmt.Sprintf(`
+locals {
+  info = provider::aws::region_info(%[1]q)
+}
+
+output "dns_suffix" {
+  value = local.info.dns_suffix
+}
+
+output "opt_in" {
+  value = local.info.opt_in
+}
+
+output "partition" {
+  value = local.info.partition
+}
+`, arg)
+}
@@ -49,14 +49,18 @@ func main() {
 			continue
 		}
 
-		// Look for Terraform Plugin Framework and SDK resource and data source annotations.
+		// Look for Terraform Plugin Framework and SDK resource and data source annotations,
+		// Terraform Plugin Framework ephemeral resource and action annotations and SDK list resource annotations.
 		// These annotations are implemented as comments on factory functions.
 		v := &visitor{
 			g: g,
 
+			actions:              make([]ResourceDatum, 0),
+			ephemeralResources:   make([]ResourceDatum, 0),
 			frameworkDataSources: make([]ResourceDatum, 0),
 			frameworkResources:   make([]ResourceDatum, 0),
 			sdkDataSources:       make(map[string]ResourceDatum),
+			sdkListResources:     make(map[string]ResourceDatum),
 			sdkResources:         make(map[string]ResourceDatum),
 		}
 
@@ -74,9 +78,12 @@ func main() {
 			GoV2Package:          l.GoV2Package(),
 			ProviderPackage:      p,
 			ProviderNameUpper:    l.ProviderNameUpper(),
+			Actions:              v.actions,
+			EphemeralResources:   v.ephemeralResources,
 			FrameworkDataSources: v.frameworkDataSources,
 			FrameworkResources:   v.frameworkResources,
 			SDKDataSources:       v.sdkDataSources,
+			SDKListResources:     v.sdkListResources,
 			SDKResources:         v.sdkResources,
 		}
 
@@ -84,6 +91,12 @@ func main() {
 			s.GoV1ClientTypeName = l.GoV1ClientTypeName()
 		}
 
+		sort.SliceStable(s.Actions, func(i, j int) bool {
+			return s.Actions[i].FactoryName < s.Actions[j].FactoryName
+		})
+		sort.SliceStable(s.EphemeralResources, func(i, j int) bool {
+			return s.EphemeralResources[i].FactoryName < s.EphemeralResources[j].FactoryName
+		})
 		sort.SliceStable(s.FrameworkDataSources, func(i, j int) bool {
 			return s.FrameworkDataSources[i].FactoryName < s.FrameworkDataSources[j].FactoryName
 		})
@@ -125,6 +138,13 @@ type ResourceDatum struct {
 	TransparentTagging      bool
 	TagsIdentifierAttribute string
 	TagsResourceType        string
+	IdentityAttributes      []string
+	GlobalIdentity          bool
+}
+
+// HasIdentity returns whether the resource has opted in to resource identity.
+func (d ResourceDatum) HasIdentity() bool {
+	return len(d.IdentityAttributes) > 0
 }
 
 type ServiceDatum struct {
@@ -136,9 +156,12 @@ type ServiceDatum struct {
 	GoV2Package          string // AWS SDK for Go v2 package name
 	ProviderPackage      string
 	ProviderNameUpper    string
+	Actions              []ResourceDatum
+	EphemeralResources   []ResourceDatum
 	FrameworkDataSources []ResourceDatum
 	FrameworkResources   []ResourceDatum
 	SDKDataSources       map[string]ResourceDatum
+	SDKListResources     map[string]ResourceDatum
 	SDKResources         map[string]ResourceDatum
 }
 
@@ -161,9 +184,12 @@ type visitor struct {
 	functionName string
 	packageName  string
 
+	actions              []ResourceDatum
+	ephemeralResources   []ResourceDatum
 	frameworkDataSources []ResourceDatum
 	frameworkResources   []ResourceDatum
 	sdkDataSources       map[string]ResourceDatum
+	sdkListResources     map[string]ResourceDatum
 	sdkResources         map[string]ResourceDatum
 }
 
@@ -202,16 +228,39 @@ func (v *visitor) processFile(file *ast.File) {
 }
 
 // processFuncDecl processes a single Go function.
-// The function's comments are scanned for annotations indicating a Plugin Framework or SDK resource or data source.
+// The function's comments are scanned for annotations indicating a Plugin Framework or SDK resource or data source, a Plugin Framework ephemeral resource or action,
+// or a list resource for an SDK resource.
 func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 	v.functionName = funcDecl.Name.Name
 
-	// Look first for tagging annotations.
+	// Look first for tagging and identity annotations.
 	d := ResourceDatum{}
 
 	for _, line := range funcDecl.Doc.List {
 		line := line.Text
 
+		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "GlobalIdentity" {
+			d.GlobalIdentity = true
+		}
+
+		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "IdentityAttribute" {
+			args := common.ParseArgs(m[3])
+
+			if len(args.Positional) == 0 {
+				v.errs = append(v.errs, fmt.Errorf("no IdentityAttribute attribute name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				continue
+			}
+
+			attr := namesgen.ConstOrQuote(args.Positional[0])
+
+			if slices.Contains(d.IdentityAttributes, attr) {
+				v.errs = append(v.errs, fmt.Errorf("duplicate IdentityAttribute (%s): %s", args.Positional[0], fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				continue
+			}
+
+			d.IdentityAttributes = append(d.IdentityAttributes, attr)
+		}
+
 		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "Tags" {
 			args := common.ParseArgs(m[3])
 
@@ -231,6 +280,10 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 		}
 	}
 
+	if d.GlobalIdentity && !d.HasIdentity() {
+		v.errs = append(v.errs, fmt.Errorf("GlobalIdentity annotation without IdentityAttribute: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+	}
+
 	for _, line := range funcDecl.Doc.List {
 		line := line.Text
 
@@ -244,9 +297,31 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 			}
 
 			switch annotationName := m[1]; annotationName {
+			case "Action":
+				if slices.ContainsFunc(v.actions, func(d ResourceDatum) bool { return d.FactoryName == v.functionName }) {
+					v.errs = append(v.errs, fmt.Errorf("duplicate Action: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				} else if d.TransparentTagging {
+					v.errs = append(v.errs, fmt.Errorf("Action cannot be tagged: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				} else if d.HasIdentity() {
+					v.errs = append(v.errs, fmt.Errorf("Action cannot have an identity: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				} else {
+					v.actions = append(v.actions, d)
+				}
+			case "EphemeralResource":
+				if slices.ContainsFunc(v.ephemeralResources, func(d ResourceDatum) bool { return d.FactoryName == v.functionName }) {
+					v.errs = append(v.errs, fmt.Errorf("duplicate Ephemeral Resource: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				} else if d.TransparentTagging {
+					v.errs = append(v.errs, fmt.Errorf("Ephemeral Resource cannot be tagged: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				} else if d.HasIdentity() {
+					v.errs = append(v.errs, fmt.Errorf("Ephemeral Resource cannot have an identity: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				} else {
+					v.ephemeralResources = append(v.ephemeralResources, d)
+				}
 			case "FrameworkDataSource":
 				if slices.ContainsFunc(v.frameworkDataSources, func(d ResourceDatum) bool { return d.FactoryName == v.functionName }) {
 					v.errs = append(v.errs, fmt.Errorf("duplicate Framework Data Source: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				} else if d.HasIdentity() {
+					v.errs = append(v.errs, fmt.Errorf("Framework Data Source cannot have an identity: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
 				} else {
 					v.frameworkDataSources = append(v.frameworkDataSources, d)
 				}
@@ -266,9 +341,28 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 
 				if _, ok := v.sdkDataSources[typeName]; ok {
 					v.errs = append(v.errs, fmt.Errorf("duplicate SDK Data Source (%s): %s", typeName, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				} else if d.HasIdentity() {
+					v.errs = append(v.errs, fmt.Errorf("SDK Data Source cannot have an identity (%s): %s", typeName, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
 				} else {
 					v.sdkDataSources[typeName] = d
 				}
+			case "SDKListResource":
+				if len(args.Positional) == 0 {
+					v.errs = append(v.errs, fmt.Errorf("no type name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+
+				typeName := args.Positional[0]
+
+				if _, ok := v.sdkListResources[typeName]; ok {
+					v.errs = append(v.errs, fmt.Errorf("duplicate SDK List Resource (%s): %s", typeName, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				} else if d.TransparentTagging {
+					v.errs = append(v.errs, fmt.Errorf("SDK List Resource cannot be tagged (%s): %s", typeName, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				} else if d.HasIdentity() {
+					v.errs = append(v.errs, fmt.Errorf("SDK List Resource cannot have an identity (%s): %s", typeName, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				} else {
+					v.sdkListResources[typeName] = d
+				}
 			case "SDKResource":
 				if len(args.Positional) == 0 {
 					v.errs = append(v.errs, fmt.Errorf("no type name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
@@ -282,7 +376,7 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 				} else {
 					v.sdkResources[typeName] = d
 				}
-			case "Tags":
+			case "GlobalIdentity", "IdentityAttribute", "Tags":
 				// Handled above.
 			case "Testing":
 				// Ignored.
@@ -6,15 +6,28 @@ package fwprovider
 import (
 	"context"
 	"fmt"
+	"maps"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -46,10 +59,10 @@ func (s dataSourceInterceptors) read() []dataSourceInterceptorReadFunc {
 }
 
 type resourceCRUDRequest interface {
-	resource.CreateRequest | resource.ReadRequest | resource.UpdateRequest | resource.DeleteRequest
+	resource.CreateRequest | resource.ReadRequest | resource.UpdateRequest | resource.DeleteRequest | resource.ModifyPlanRequest
 }
 type resourceCRUDResponse interface {
-	resource.CreateResponse | resource.ReadResponse | resource.UpdateResponse | resource.DeleteResponse
+	resource.CreateResponse | resource.ReadResponse | resource.UpdateResponse | resource.DeleteResponse | resource.ModifyPlanResponse
 }
 
 // A resource interceptor is functionality invoked during the resource's CRUD request lifecycle.
@@ -65,6 +78,8 @@ type resourceInterceptor interface {
 	update(context.Context, resource.UpdateRequest, *resource.UpdateResponse, *conns.AWSClient, when, diag.Diagnostics) (context.Context, diag.Diagnostics)
 	// delete is invoke for a Delete call.
 	delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse, *conns.AWSClient, when, diag.Diagnostics) (context.Context, diag.Diagnostics)
+	// modifyPlan is invoked for a ModifyPlan call.
+	modifyPlan(context.Context, resource.ModifyPlanRequest, *resource.ModifyPlanResponse, *conns.AWSClient, when, diag.Diagnostics) (context.Context, diag.Diagnostics)
 }
 
 type resourceInterceptors []resourceInterceptor
@@ -99,6 +114,13 @@ func (s resourceInterceptors) delete() []resourceInterceptorFunc[resource.Delete
 	})
 }
 
+// modifyPlan returns a slice of interceptors that run on resource ModifyPlan.
+func (s resourceInterceptors) modifyPlan() []resourceInterceptorFunc[resource.ModifyPlanRequest, resource.ModifyPlanResponse] {
+	return slices.ApplyToAll(s, func(e resourceInterceptor) resourceInterceptorFunc[resource.ModifyPlanRequest, resource.ModifyPlanResponse] {
+		return e.modifyPlan
+	})
+}
+
 // when represents the point in the CRUD request lifecycle that an interceptor is run.
 // Multiple values can be ORed together.
 type when uint16
@@ -124,7 +146,11 @@ func interceptedDataSourceReadHandler(interceptors []dataSourceInterceptorReadFu
 			ctx, diags = v(ctx, request, response, meta, when, diags)
 
 			// Short circuit if any Before interceptor errors.
+			// Finally interceptors aren't run, so end the operation span started by the tracing interceptor here.
 			if diags.HasError() {
+				if meta != nil {
+					meta.EndOperationSpan(ctx, fwdiag.DiagnosticsError(diags))
+				}
 				return diags
 			}
 		}
@@ -163,7 +189,11 @@ func interceptedResourceHandler[Request resourceCRUDRequest, Response resourceCR
 			ctx, diags = v(ctx, request, response, meta, when, diags)
 
 			// Short circuit if any Before interceptor errors.
+			// Finally interceptors aren't run, so end the operation span started by the tracing interceptor here.
 			if diags.HasError() {
+				if meta != nil {
+					meta.EndOperationSpan(ctx, fwdiag.DiagnosticsError(diags))
+				}
 				return diags
 			}
 		}
@@ -200,13 +230,16 @@ type wrappedDataSource struct {
 	inner            datasource.DataSourceWithConfigure
 	interceptors     dataSourceInterceptors
 	meta             *conns.AWSClient
+	// perResourceRegion is set if the per-resource `region` argument is injected into the schema.
+	perResourceRegion bool
 }
 
-func newWrappedDataSource(bootstrapContext contextFunc, inner datasource.DataSourceWithConfigure, interceptors dataSourceInterceptors) datasource.DataSourceWithConfigure {
+func newWrappedDataSource(bootstrapContext contextFunc, inner datasource.DataSourceWithConfigure, interceptors dataSourceInterceptors, perResourceRegion bool) datasource.DataSourceWithConfigure {
 	return &wrappedDataSource{
-		bootstrapContext: bootstrapContext,
-		inner:            inner,
-		interceptors:     interceptors,
+		bootstrapContext:  bootstrapContext,
+		inner:             inner,
+		interceptors:      interceptors,
+		perResourceRegion: perResourceRegion,
 	}
 }
 
@@ -218,10 +251,31 @@ func (w *wrappedDataSource) Metadata(ctx context.Context, request datasource.Met
 func (w *wrappedDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
 	ctx = w.bootstrapContext(ctx, w.meta)
 	w.inner.Schema(ctx, request, response)
+
+	if w.perResourceRegion {
+		response.Schema.Attributes = maps.Clone(response.Schema.Attributes)
+		response.Schema.Attributes[names.AttrRegion] = regionDataSourceSchemaAttribute()
+	}
+}
+
+// innerSchema returns the inner data source's schema, which has no per-resource `region` attribute.
+func (w *wrappedDataSource) innerSchema(ctx context.Context) dsschema.Schema {
+	response := datasource.SchemaResponse{}
+	w.inner.Schema(ctx, datasource.SchemaRequest{}, &response)
+
+	return response.Schema
 }
 
 func (w *wrappedDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
 	f := func(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) diag.Diagnostics {
+		if w.perResourceRegion {
+			s, state := w.innerSchema(ctx), response.State
+			request.Config = tfsdk.Config{Raw: withoutRegion(request.Config.Raw), Schema: s}
+			response.State = tfsdk.State{Raw: withoutRegion(state.Raw), Schema: s}
+			defer func() {
+				response.State = tfsdk.State{Raw: withRegion(response.State.Raw, regionValue(state.Raw)), Schema: state.Schema}
+			}()
+		}
 		w.inner.Read(ctx, request, response)
 		return response.Diagnostics
 	}
@@ -248,6 +302,228 @@ func (r tagsDataSourceInterceptor) read(ctx context.Context, request datasource.
 	return ctx, diags
 }
 
+// wrappedEphemeralResource represents an interceptor dispatcher for a Plugin Framework ephemeral resource.
+type wrappedEphemeralResource struct {
+	// bootstrapContext is run on all wrapped methods before any interceptors.
+	bootstrapContext contextFunc
+	inner            ephemeral.EphemeralResourceWithConfigure
+	meta             *conns.AWSClient
+}
+
+func newWrappedEphemeralResource(bootstrapContext contextFunc, inner ephemeral.EphemeralResourceWithConfigure) ephemeral.EphemeralResourceWithConfigure {
+	return &wrappedEphemeralResource{
+		bootstrapContext: bootstrapContext,
+		inner:            inner,
+	}
+}
+
+func (w *wrappedEphemeralResource) Metadata(ctx context.Context, request ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.Metadata(ctx, request, response)
+}
+
+func (w *wrappedEphemeralResource) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.Schema(ctx, request, response)
+}
+
+func (w *wrappedEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.Open(ctx, request, response)
+}
+
+func (w *wrappedEphemeralResource) Configure(ctx context.Context, request ephemeral.ConfigureRequest, response *ephemeral.ConfigureResponse) {
+	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
+		w.meta = v
+	}
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.Configure(ctx, request, response)
+}
+
+func (w *wrappedEphemeralResource) Renew(ctx context.Context, request ephemeral.RenewRequest, response *ephemeral.RenewResponse) {
+	if v, ok := w.inner.(ephemeral.EphemeralResourceWithRenew); ok {
+		ctx = w.bootstrapContext(ctx, w.meta)
+		v.Renew(ctx, request, response)
+	}
+}
+
+func (w *wrappedEphemeralResource) Close(ctx context.Context, request ephemeral.CloseRequest, response *ephemeral.CloseResponse) {
+	if v, ok := w.inner.(ephemeral.EphemeralResourceWithClose); ok {
+		ctx = w.bootstrapContext(ctx, w.meta)
+		v.Close(ctx, request, response)
+	}
+}
+
+func (w *wrappedEphemeralResource) ConfigValidators(ctx context.Context) []ephemeral.ConfigValidator {
+	if v, ok := w.inner.(ephemeral.EphemeralResourceWithConfigValidators); ok {
+		ctx = w.bootstrapContext(ctx, w.meta)
+		return v.ConfigValidators(ctx)
+	}
+
+	return nil
+}
+
+func (w *wrappedEphemeralResource) ValidateConfig(ctx context.Context, request ephemeral.ValidateConfigRequest, response *ephemeral.ValidateConfigResponse) {
+	if v, ok := w.inner.(ephemeral.EphemeralResourceWithValidateConfig); ok {
+		ctx = w.bootstrapContext(ctx, w.meta)
+		v.ValidateConfig(ctx, request, response)
+	}
+}
+
+// wrappedAction represents an interceptor dispatcher for a Plugin Framework action.
+type wrappedAction struct {
+	// bootstrapContext is run on all wrapped methods before any interceptors.
+	bootstrapContext contextFunc
+	inner            action.ActionWithConfigure
+	meta             *conns.AWSClient
+}
+
+func newWrappedAction(bootstrapContext contextFunc, inner action.ActionWithConfigure) action.ActionWithConfigure {
+	return &wrappedAction{
+		bootstrapContext: bootstrapContext,
+		inner:            inner,
+	}
+}
+
+func (w *wrappedAction) Metadata(ctx context.Context, request action.MetadataRequest, response *action.MetadataResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.Metadata(ctx, request, response)
+}
+
+func (w *wrappedAction) Schema(ctx context.Context, request action.SchemaRequest, response *action.SchemaResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.Schema(ctx, request, response)
+}
+
+func (w *wrappedAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.Invoke(ctx, request, response)
+}
+
+func (w *wrappedAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
+		w.meta = v
+	}
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.Configure(ctx, request, response)
+}
+
+func (w *wrappedAction) ModifyPlan(ctx context.Context, request action.ModifyPlanRequest, response *action.ModifyPlanResponse) {
+	if v, ok := w.inner.(action.ActionWithModifyPlan); ok {
+		ctx = w.bootstrapContext(ctx, w.meta)
+		v.ModifyPlan(ctx, request, response)
+	}
+}
+
+func (w *wrappedAction) ConfigValidators(ctx context.Context) []action.ConfigValidator {
+	if v, ok := w.inner.(action.ActionWithConfigValidators); ok {
+		ctx = w.bootstrapContext(ctx, w.meta)
+		return v.ConfigValidators(ctx)
+	}
+
+	return nil
+}
+
+func (w *wrappedAction) ValidateConfig(ctx context.Context, request action.ValidateConfigRequest, response *action.ValidateConfigResponse) {
+	if v, ok := w.inner.(action.ActionWithValidateConfig); ok {
+		ctx = w.bootstrapContext(ctx, w.meta)
+		v.ValidateConfig(ctx, request, response)
+	}
+}
+
+// wrappedListResource represents an interceptor dispatcher for a Plugin Framework list resource.
+type wrappedListResource struct {
+	// bootstrapContext is run on all wrapped methods before any interceptors.
+	bootstrapContext contextFunc
+	inner            list.ListResourceWithConfigure
+	meta             *conns.AWSClient
+	// perResourceRegion is set if the per-resource `region` argument is injected into the schema.
+	perResourceRegion bool
+}
+
+func newWrappedListResource(bootstrapContext contextFunc, inner list.ListResourceWithConfigure, perResourceRegion bool) list.ListResourceWithConfigure {
+	return &wrappedListResource{
+		bootstrapContext:  bootstrapContext,
+		inner:             inner,
+		perResourceRegion: perResourceRegion,
+	}
+}
+
+func (w *wrappedListResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.Metadata(ctx, request, response)
+}
+
+func (w *wrappedListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.ListResourceConfigSchema(ctx, request, response)
+
+	if w.perResourceRegion {
+		if response.Schema.Attributes == nil {
+			response.Schema.Attributes = make(map[string]listschema.Attribute)
+		}
+		response.Schema.Attributes[names.AttrRegion] = regionListResourceSchemaAttribute()
+	}
+}
+
+func (w *wrappedListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+
+	if w.perResourceRegion {
+		region, diags := regionFromAttribute(ctx, request.Config.GetAttribute, w.meta)
+		if diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+
+		if region != "" {
+			ctx = conns.WithRegion(ctx, region)
+		}
+
+		schemaResponse := list.ListResourceSchemaResponse{}
+		w.inner.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &schemaResponse)
+		request.Config = tfsdk.Config{Raw: withoutRegion(request.Config.Raw), Schema: schemaResponse.Schema}
+	}
+
+	w.inner.List(ctx, request, stream)
+}
+
+func (w *wrappedListResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
+		w.meta = v
+	}
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.Configure(ctx, request, response)
+}
+
+func (w *wrappedListResource) RawV5Schemas(ctx context.Context, request list.RawV5SchemaRequest, response *list.RawV5SchemaResponse) {
+	if v, ok := w.inner.(list.ListResourceWithRawV5Schemas); ok {
+		ctx = w.bootstrapContext(ctx, w.meta)
+		v.RawV5Schemas(ctx, request, response)
+	}
+}
+
+func (w *wrappedListResource) ListResourceConfigValidators(ctx context.Context) []list.ConfigValidator {
+	if v, ok := w.inner.(list.ListResourceWithConfigValidators); ok {
+		ctx = w.bootstrapContext(ctx, w.meta)
+		return v.ListResourceConfigValidators(ctx)
+	}
+
+	return nil
+}
+
+func (w *wrappedListResource) ValidateListResourceConfig(ctx context.Context, request list.ValidateConfigRequest, response *list.ValidateConfigResponse) {
+	if v, ok := w.inner.(list.ListResourceWithValidateConfig); ok {
+		ctx = w.bootstrapContext(ctx, w.meta)
+		if w.perResourceRegion {
+			schemaResponse := list.ListResourceSchemaResponse{}
+			w.inner.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &schemaResponse)
+			request.Config = tfsdk.Config{Raw: withoutRegion(request.Config.Raw), Schema: schemaResponse.Schema}
+		}
+		v.ValidateListResourceConfig(ctx, request, response)
+	}
+}
+
 // wrappedResource represents an interceptor dispatcher for a Plugin Framework resource.
 type wrappedResource struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
@@ -255,13 +531,16 @@ type wrappedResource struct {
 	inner            resource.ResourceWithConfigure
 	interceptors     resourceInterceptors
 	meta             *conns.AWSClient
+	// perResourceRegion is set if the per-resource `region` argument is injected into the schema.
+	perResourceRegion bool
 }
 
-func newWrappedResource(bootstrapContext contextFunc, inner resource.ResourceWithConfigure, interceptors resourceInterceptors) resource.ResourceWithConfigure {
+func newWrappedResource(bootstrapContext contextFunc, inner resource.ResourceWithConfigure, interceptors resourceInterceptors, perResourceRegion bool) resource.ResourceWithConfigure {
 	return &wrappedResource{
-		bootstrapContext: bootstrapContext,
-		inner:            inner,
-		interceptors:     interceptors,
+		bootstrapContext:  bootstrapContext,
+		inner:             inner,
+		interceptors:      interceptors,
+		perResourceRegion: perResourceRegion,
 	}
 }
 
@@ -273,10 +552,40 @@ func (w *wrappedResource) Metadata(ctx context.Context, request resource.Metadat
 func (w *wrappedResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
 	ctx = w.bootstrapContext(ctx, w.meta)
 	w.inner.Schema(ctx, request, response)
+
+	if w.perResourceRegion {
+		response.Schema.Attributes = maps.Clone(response.Schema.Attributes)
+		response.Schema.Attributes[names.AttrRegion] = regionResourceSchemaAttribute()
+	}
+}
+
+// innerSchema returns the inner resource's schema, which has no per-resource `region` attribute.
+func (w *wrappedResource) innerSchema(ctx context.Context) schema.Schema {
+	response := resource.SchemaResponse{}
+	w.inner.Schema(ctx, resource.SchemaRequest{}, &response)
+
+	return response.Schema
+}
+
+// innerState returns a copy of the specified State with the per-resource `region` attribute removed.
+func (w *wrappedResource) innerState(ctx context.Context, state tfsdk.State) tfsdk.State {
+	return tfsdk.State{Raw: withoutRegion(state.Raw), Schema: w.innerSchema(ctx)}
+}
+
+// outerState returns a copy of the specified inner State with the per-resource `region` attribute from the original State added.
+func (w *wrappedResource) outerState(state, original tfsdk.State) tfsdk.State {
+	return tfsdk.State{Raw: withRegion(state.Raw, regionValue(original.Raw)), Schema: original.Schema}
 }
 
 func (w *wrappedResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
 	f := func(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) diag.Diagnostics {
+		if w.perResourceRegion {
+			s, state := w.innerSchema(ctx), response.State
+			request.Config = tfsdk.Config{Raw: withoutRegion(request.Config.Raw), Schema: s}
+			request.Plan = tfsdk.Plan{Raw: withoutRegion(request.Plan.Raw), Schema: s}
+			response.State = w.innerState(ctx, state)
+			defer func() { response.State = w.outerState(response.State, state) }()
+		}
 		w.inner.Create(ctx, request, response)
 		return response.Diagnostics
 	}
@@ -287,6 +596,12 @@ func (w *wrappedResource) Create(ctx context.Context, request resource.CreateReq
 
 func (w *wrappedResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
 	f := func(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) diag.Diagnostics {
+		if w.perResourceRegion {
+			state := response.State
+			request.State = w.innerState(ctx, request.State)
+			response.State = w.innerState(ctx, state)
+			defer func() { response.State = w.outerState(response.State, state) }()
+		}
 		w.inner.Read(ctx, request, response)
 		return response.Diagnostics
 	}
@@ -297,6 +612,14 @@ func (w *wrappedResource) Read(ctx context.Context, request resource.ReadRequest
 
 func (w *wrappedResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
 	f := func(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) diag.Diagnostics {
+		if w.perResourceRegion {
+			s, state := w.innerSchema(ctx), response.State
+			request.Config = tfsdk.Config{Raw: withoutRegion(request.Config.Raw), Schema: s}
+			request.Plan = tfsdk.Plan{Raw: withoutRegion(request.Plan.Raw), Schema: s}
+			request.State = w.innerState(ctx, request.State)
+			response.State = w.innerState(ctx, state)
+			defer func() { response.State = w.outerState(response.State, state) }()
+		}
 		w.inner.Update(ctx, request, response)
 		return response.Diagnostics
 	}
@@ -307,6 +630,12 @@ func (w *wrappedResource) Update(ctx context.Context, request resource.UpdateReq
 
 func (w *wrappedResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
 	f := func(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) diag.Diagnostics {
+		if w.perResourceRegion {
+			state := response.State
+			request.State = w.innerState(ctx, request.State)
+			response.State = w.innerState(ctx, state)
+			defer func() { response.State = w.outerState(response.State, state) }()
+		}
 		w.inner.Delete(ctx, request, response)
 		return response.Diagnostics
 	}
@@ -326,7 +655,30 @@ func (w *wrappedResource) Configure(ctx context.Context, request resource.Config
 func (w *wrappedResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
 	if v, ok := w.inner.(resource.ResourceWithImportState); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
+
+		if !w.perResourceRegion {
+			v.ImportState(ctx, request, response)
+
+			return
+		}
+
+		// The import ID can specify a per-resource Region, e.g. `my-log-group@eu-west-1`.
+		if id, region := conns.SplitImportIDRegion(request.ID); region != "" {
+			if err := w.meta.ValidateRegion(region); err != nil {
+				response.Diagnostics.AddAttributeError(path.Root(names.AttrRegion), "Invalid Region", err.Error())
+				return
+			}
+
+			request.ID = id
+			ctx = conns.WithRegion(ctx, region)
+		}
+
 		v.ImportState(ctx, request, response)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrRegion), w.meta.Region(ctx))...)
 
 		return
 	}
@@ -338,10 +690,25 @@ func (w *wrappedResource) ImportState(ctx context.Context, request resource.Impo
 }
 
 func (w *wrappedResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
-	if v, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
-		ctx = w.bootstrapContext(ctx, w.meta)
-		v.ModifyPlan(ctx, request, response)
+	f := func(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) diag.Diagnostics {
+		if v, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
+			if w.perResourceRegion {
+				s, plan := w.innerSchema(ctx), response.Plan
+				request.Config = tfsdk.Config{Raw: withoutRegion(request.Config.Raw), Schema: s}
+				request.Plan = tfsdk.Plan{Raw: withoutRegion(request.Plan.Raw), Schema: s}
+				request.State = w.innerState(ctx, request.State)
+				response.Plan = tfsdk.Plan{Raw: withoutRegion(plan.Raw), Schema: s}
+				defer func() {
+					response.Plan = tfsdk.Plan{Raw: withRegion(response.Plan.Raw, regionValue(plan.Raw)), Schema: plan.Schema}
+				}()
+			}
+			v.ModifyPlan(ctx, request, response)
+		}
+		return response.Diagnostics
 	}
+	ctx = w.bootstrapContext(ctx, w.meta)
+	diags := interceptedResourceHandler(w.interceptors.modifyPlan(), f, w.meta)(ctx, request, response)
+	response.Diagnostics = diags
 }
 
 func (w *wrappedResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
@@ -356,6 +723,9 @@ func (w *wrappedResource) ConfigValidators(ctx context.Context) []resource.Confi
 func (w *wrappedResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
 	if v, ok := w.inner.(resource.ResourceWithValidateConfig); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
+		if w.perResourceRegion {
+			request.Config = tfsdk.Config{Raw: withoutRegion(request.Config.Raw), Schema: w.innerSchema(ctx)}
+		}
 		v.ValidateConfig(ctx, request, response)
 	}
 }
@@ -363,8 +733,22 @@ func (w *wrappedResource) ValidateConfig(ctx context.Context, request resource.V
 func (w *wrappedResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
 	if v, ok := w.inner.(resource.ResourceWithUpgradeState); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
+		upgraders := v.UpgradeState(ctx)
+
+		if w.perResourceRegion {
+			for k, upgrader := range upgraders {
+				f := upgrader.StateUpgrader
+				upgrader.StateUpgrader = func(ctx context.Context, request resource.UpgradeStateRequest, response *resource.UpgradeStateResponse) {
+					state := response.State
+					response.State = w.innerState(ctx, state)
+					f(ctx, request, response)
+					response.State = w.outerState(response.State, state)
+				}
+				upgraders[k] = upgrader
+			}
+		}
 
-		return v.UpgradeState(ctx)
+		return upgraders
 	}
 
 	return nil
@@ -373,16 +757,128 @@ func (w *wrappedResource) UpgradeState(ctx context.Context) map[int64]resource.S
 func (w *wrappedResource) MoveState(ctx context.Context) []resource.StateMover {
 	if v, ok := w.inner.(resource.ResourceWithMoveState); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
+		movers := v.MoveState(ctx)
+
+		if w.perResourceRegion {
+			for i, mover := range movers {
+				f := mover.StateMover
+				mover.StateMover = func(ctx context.Context, request resource.MoveStateRequest, response *resource.MoveStateResponse) {
+					state := response.TargetState
+					response.TargetState = w.innerState(ctx, state)
+					f(ctx, request, response)
+					response.TargetState = w.outerState(response.TargetState, state)
+				}
+				movers[i] = mover
+			}
+		}
 
-		return v.MoveState(ctx)
+		return movers
 	}
 
 	return nil
 }
 
+// wrappedResourceWithIdentity represents an interceptor dispatcher for a Plugin Framework resource with a resource identity.
+type wrappedResourceWithIdentity struct {
+	*wrappedResource
+	identity *types.ServicePackageResourceIdentity
+}
+
+func newWrappedResourceWithIdentity(bootstrapContext contextFunc, inner resource.ResourceWithConfigure, interceptors resourceInterceptors, perResourceRegion bool, identity *types.ServicePackageResourceIdentity) resource.ResourceWithConfigure {
+	return &wrappedResourceWithIdentity{
+		wrappedResource: &wrappedResource{
+			bootstrapContext:  bootstrapContext,
+			inner:             inner,
+			interceptors:      interceptors,
+			perResourceRegion: perResourceRegion,
+		},
+		identity: identity,
+	}
+}
+
+func (w *wrappedResourceWithIdentity) IdentitySchema(ctx context.Context, request resource.IdentitySchemaRequest, response *resource.IdentitySchemaResponse) {
+	attributes := map[string]identityschema.Attribute{
+		names.AttrAccountID: identityschema.StringAttribute{
+			OptionalForImport: true,
+		},
+	}
+
+	if !w.identity.Global {
+		attributes[names.AttrRegion] = identityschema.StringAttribute{
+			OptionalForImport: true,
+		}
+	}
+
+	for _, attr := range w.identity.Attributes {
+		attributes[attr] = identityschema.StringAttribute{
+			RequiredForImport: true,
+		}
+	}
+
+	response.IdentitySchema = identityschema.Schema{
+		Attributes: attributes,
+	}
+}
+
+// ImportState allows the resource to be imported by identity.
+// The import ID is the natural key attribute values joined by the resource ID separator.
+func (w *wrappedResourceWithIdentity) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	// Imported by identity.
+	if request.ID == "" && request.Identity != nil && !request.Identity.Raw.IsNull() {
+		var accountID, region *string
+		response.Diagnostics.Append(request.Identity.GetAttribute(ctx, path.Root(names.AttrAccountID), &accountID)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if accountID != nil && *accountID != w.meta.AccountID {
+			response.Diagnostics.AddAttributeError(path.Root(names.AttrAccountID), "Invalid Resource Identity", fmt.Sprintf("identity %s (%s) does not match provider AWS account ID (%s)", names.AttrAccountID, *accountID, w.meta.AccountID))
+			return
+		}
+
+		if !w.identity.Global {
+			response.Diagnostics.Append(request.Identity.GetAttribute(ctx, path.Root(names.AttrRegion), &region)...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+
+			if region != nil && *region != w.meta.Region(ctx) && !w.perResourceRegion {
+				response.Diagnostics.AddAttributeError(path.Root(names.AttrRegion), "Invalid Resource Identity", fmt.Sprintf("identity %s (%s) does not match provider Region (%s)", names.AttrRegion, *region, w.meta.Region(ctx)))
+				return
+			}
+		}
+
+		parts := make([]string, 0, len(w.identity.Attributes))
+		for _, attr := range w.identity.Attributes {
+			var v *string
+			response.Diagnostics.Append(request.Identity.GetAttribute(ctx, path.Root(attr), &v)...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+
+			if v == nil {
+				response.Diagnostics.AddAttributeError(path.Root(attr), "Invalid Resource Identity", fmt.Sprintf("identity %s not set", attr))
+				return
+			}
+
+			parts = append(parts, *v)
+		}
+
+		request.ID = strings.Join(parts, intflex.ResourceIdSeparator)
+
+		// The identity's Region selects the resource's per-resource Region.
+		if region != nil && *region != w.meta.Region(ctx) {
+			request.ID += conns.ImportIDRegionSeparator + *region
+		}
+	}
+
+	w.wrappedResource.ImportState(ctx, request, response)
+}
+
 // tagsResourceInterceptor implements transparent tagging for resources.
 type tagsResourceInterceptor struct {
-	tags *types.ServicePackageResourceTags
+	tags     *types.ServicePackageResourceTags
+	typeName string
 }
 
 func (r tagsResourceInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
@@ -653,3 +1149,132 @@ func (r tagsResourceInterceptor) update(ctx context.Context, request resource.Up
 func (r tagsResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
 	return ctx, diags
 }
+
+func (r tagsResourceInterceptor) modifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if r.tags == nil {
+		return ctx, diags
+	}
+
+	if meta == nil || meta.TagPolicyConfig == nil {
+		return ctx, diags
+	}
+
+	switch when {
+	case Before:
+		// Resource is being destroyed.
+		if request.Plan.Raw.IsNull() {
+			return ctx, diags
+		}
+
+		var planTags fwtypes.Map
+		diags.Append(request.Plan.GetAttribute(ctx, path.Root(names.AttrTags), &planTags)...)
+
+		if diags.HasError() {
+			return ctx, diags
+		}
+
+		// Tags that are unknown during plan are validated during apply.
+		if planTags.IsUnknown() {
+			return ctx, diags
+		}
+		for _, v := range planTags.Elements() {
+			if v.IsUnknown() {
+				return ctx, diags
+			}
+		}
+
+		for _, v := range meta.TagPolicyConfig.Validate(meta.DefaultTagsConfig, meta.IgnoreTagsConfig, tftags.New(ctx, planTags)) {
+			diags.AddAttributeError(path.Root(names.AttrTags), "Tag Policy Violation", fmt.Sprintf("%s does not comply with tag_policy: %s", r.typeName, v))
+		}
+	}
+
+	return ctx, diags
+}
+
+// validateIdentityAttributes ensures that a resource's natural key attributes are suitable for use in its identity.
+func validateIdentityAttributes(attributes map[string]schema.Attribute, identity *types.ServicePackageResourceIdentity) error {
+	for _, attr := range identity.Attributes {
+		switch attr {
+		case names.AttrAccountID, names.AttrRegion:
+			return fmt.Errorf("`%s` cannot be an identity attribute", attr)
+		}
+
+		v, ok := attributes[attr]
+		if !ok {
+			return fmt.Errorf("no `%s` attribute defined in schema", attr)
+		}
+		if _, ok := v.GetType().(basetypes.StringTypable); !ok {
+			return fmt.Errorf("identity attribute `%s` must be a string", attr)
+		}
+	}
+
+	return nil
+}
+
+// identityResourceInterceptor implements resource identity for resources.
+type identityResourceInterceptor struct {
+	identity *types.ServicePackageResourceIdentity
+}
+
+func (r identityResourceInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case After:
+		diags.Append(r.setIdentity(ctx, response.State, response.Identity, meta)...)
+	}
+
+	return ctx, diags
+}
+
+func (r identityResourceInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case After:
+		diags.Append(r.setIdentity(ctx, response.State, response.Identity, meta)...)
+	}
+
+	return ctx, diags
+}
+
+func (r identityResourceInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case After:
+		diags.Append(r.setIdentity(ctx, response.State, response.Identity, meta)...)
+	}
+
+	return ctx, diags
+}
+
+func (r identityResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+func (r identityResourceInterceptor) modifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+// setIdentity sets the resource's identity from its state.
+func (r identityResourceInterceptor) setIdentity(ctx context.Context, state tfsdk.State, identity *tfsdk.ResourceIdentity, meta *conns.AWSClient) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Resource has been removed from state.
+	if identity == nil || state.Raw.IsNull() {
+		return diags
+	}
+
+	diags.Append(identity.SetAttribute(ctx, path.Root(names.AttrAccountID), meta.AccountID)...)
+
+	if !r.identity.Global {
+		diags.Append(identity.SetAttribute(ctx, path.Root(names.AttrRegion), meta.Region(ctx))...)
+	}
+
+	for _, attr := range r.identity.Attributes {
+		var v *string
+		diags.Append(state.GetAttribute(ctx, path.Root(attr), &v)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		diags.Append(identity.SetAttribute(ctx, path.Root(attr), v)...)
+	}
+
+	return diags
+}
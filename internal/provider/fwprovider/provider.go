@@ -9,15 +9,20 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 	tffunction "github.com/hashicorp/terraform-provider-aws/internal/function"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -26,6 +31,9 @@ import (
 
 var _ provider.Provider = &fwprovider{}
 var _ provider.ProviderWithFunctions = &fwprovider{}
+var _ provider.ProviderWithEphemeralResources = &fwprovider{}
+var _ provider.ProviderWithActions = &fwprovider{}
+var _ provider.ProviderWithListResources = &fwprovider{}
 
 // New returns a new, initialized Terraform Plugin Framework-style provider instance.
 // The provider instance is fully configured once the `Configure` method has been called.
@@ -167,9 +175,7 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 		},
 		Blocks: map[string]schema.Block{
 			"assume_role": schema.ListNestedBlock{
-				Validators: []validator.List{
-					listvalidator.SizeAtMost(1),
-				},
+				Description: "IAM Roles to assume in order, each using the credentials of the previous role.",
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"duration": schema.StringAttribute{
@@ -280,6 +286,16 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 							Optional:    true,
 							Description: "Resource tag key prefixes to ignore across all resources.",
 						},
+						"key_regexes": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Regular expressions matching resource tag keys to ignore across all resources.",
+						},
+						"key_value_pairs": schema.MapAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource tags to ignore across all resources only if both the tag key and value match.",
+						},
 						"keys": schema.SetAttribute{
 							ElementType: types.StringType,
 							Optional:    true,
@@ -288,6 +304,107 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 					},
 				},
 			},
+			"service_concurrency": schema.ListNestedBlock{
+				Description: "Configuration block with settings to limit the number of concurrent API operations for a service.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"limit": schema.Int64Attribute{
+							Required:    true,
+							Description: "The maximum number of concurrent API operations.",
+						},
+						"operations": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "The API operations that share the limit. If not set, the limit is shared by all of the service's operations.",
+						},
+						"service": schema.StringAttribute{
+							Required:    true,
+							Description: "The service, as used in the `endpoints` configuration block.",
+						},
+					},
+				},
+			},
+			"service_limits": schema.ListNestedBlock{
+				Description: "Configuration block with settings to override the retry settings for a service.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"max_retries": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The maximum number of times an AWS API request to the service is retried.",
+						},
+						"service": schema.StringAttribute{
+							Required:    true,
+							Description: "The service, as used in the `endpoints` configuration block.",
+						},
+						"token_bucket_rate_limiter_capacity": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The capacity of the token bucket rate limiter shared by the service's API clients.",
+						},
+					},
+				},
+			},
+			"tag_policy": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings to validate resource tags across all resources.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"key_case": schema.StringAttribute{
+							Optional:    true,
+							Description: "Case that resource tag keys must use. Valid values are `camel`, `lower`, `pascal` and `upper`.",
+						},
+						"required_keys": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource tag keys that must be set on all resources.",
+						},
+						"value_case": schema.StringAttribute{
+							Optional:    true,
+							Description: "Case that resource tag values must use. Valid values are `camel`, `lower`, `pascal` and `upper`.",
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"allowed_values": schema.SetNestedBlock{
+							Description: "Regular expressions that resource tag values must match.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrKey: schema.StringAttribute{
+										Required:    true,
+										Description: "Resource tag key.",
+									},
+									"pattern": schema.StringAttribute{
+										Required:    true,
+										Description: "Regular expression that the entire resource tag value must match.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"tracing": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings to export OpenTelemetry traces of resource operations and AWS API calls.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrEndpoint: schema.StringAttribute{
+							Optional:    true,
+							Description: "The OTLP/HTTP collector endpoint to export traces to, e.g. `localhost:4318`.",
+						},
+						"file": schema.StringAttribute{
+							Optional:    true,
+							Description: "The path of a file to append traces to in OTLP JSON format.",
+						},
+						"insecure": schema.BoolAttribute{
+							Optional:    true,
+							Description: "Whether to export traces to the collector endpoint using HTTP rather than HTTPS.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -300,6 +417,9 @@ func (p *fwprovider) Configure(ctx context.Context, request provider.ConfigureRe
 	v := p.Primary.Meta()
 	response.DataSourceData = v
 	response.ResourceData = v
+	response.EphemeralResourceData = v
+	response.ActionData = v
+	response.ListResourceData = v
 }
 
 // DataSources returns a slice of functions to instantiate each DataSource
@@ -341,7 +461,22 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 
 				return ctx
 			}
-			interceptors := dataSourceInterceptors{}
+			interceptors := dataSourceInterceptors{
+				// Tracing must be the first interceptor so that its span covers all others.
+				tracingDataSourceInterceptor{typeName: typeName},
+			}
+
+			perResourceRegion := false
+			if !names.IsGlobal(servicePackageName) {
+				// Regional data sources have a per-resource `region` argument unless the schema already defines one.
+				schemaResponse := datasource.SchemaResponse{}
+				inner.Schema(ctx, datasource.SchemaRequest{}, &schemaResponse)
+
+				if _, ok := schemaResponse.Schema.Attributes[names.AttrRegion]; !ok {
+					perResourceRegion = true
+					interceptors = append(interceptors, regionDataSourceInterceptor{})
+				}
+			}
 
 			if v.Tags != nil {
 				// The data source has opted in to transparent tagging.
@@ -363,7 +498,7 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 			}
 
 			dataSources = append(dataSources, func() datasource.DataSource {
-				return newWrappedDataSource(bootstrapContext, inner, interceptors)
+				return newWrappedDataSource(bootstrapContext, inner, interceptors, perResourceRegion)
 			})
 		}
 	}
@@ -412,7 +547,27 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 
 				return ctx
 			}
-			interceptors := resourceInterceptors{}
+			interceptors := resourceInterceptors{
+				// Tracing must be the first interceptor so that its span covers all others.
+				tracingResourceInterceptor{typeName: typeName},
+			}
+
+			schemaResponse := resource.SchemaResponse{}
+			inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+
+			perResourceRegion := false
+			if !names.IsGlobal(servicePackageName) {
+				// Regional resources have a per-resource `region` argument unless the schema already defines one.
+				if _, ok := schemaResponse.Schema.Attributes[names.AttrRegion]; !ok {
+					perResourceRegion = true
+					interceptors = append(interceptors, regionResourceInterceptor{})
+				}
+			}
+
+			// Resources that define the `retry_policy` nested block honor the configured retry policy.
+			if framework.HasRetryPolicyBlock(schemaResponse.Schema) {
+				interceptors = append(interceptors, retryPolicyResourceInterceptor{})
+			}
 
 			if v.Tags != nil {
 				// The resource has opted in to transparent tagging.
@@ -439,11 +594,31 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 					continue
 				}
 
-				interceptors = append(interceptors, tagsResourceInterceptor{tags: v.Tags})
+				interceptors = append(interceptors, tagsResourceInterceptor{tags: v.Tags, typeName: typeName})
+			}
+
+			if v := v.Identity; v != nil {
+				// The resource has opted in to resource identity.
+				// Ensure that the schema look OK.
+				schemaResponse := resource.SchemaResponse{}
+				inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+
+				if err := validateIdentityAttributes(schemaResponse.Schema.Attributes, v); err != nil {
+					errs = append(errs, fmt.Errorf("%w: %s", err, typeName))
+					continue
+				}
+
+				interceptors = append(interceptors, identityResourceInterceptor{identity: v})
+
+				resources = append(resources, func() resource.Resource {
+					return newWrappedResourceWithIdentity(bootstrapContext, inner, interceptors, perResourceRegion, v)
+				})
+
+				continue
 			}
 
 			resources = append(resources, func() resource.Resource {
-				return newWrappedResource(bootstrapContext, inner, interceptors)
+				return newWrappedResource(bootstrapContext, inner, interceptors, perResourceRegion)
 			})
 		}
 	}
@@ -457,6 +632,186 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 	return resources
 }
 
+// EphemeralResources returns a slice of functions to instantiate each EphemeralResource
+// implementation.
+//
+// The ephemeral resource type name is determined by the EphemeralResource implementing
+// the Metadata method. All ephemeral resources must have unique names.
+func (p *fwprovider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	var errs []error
+	var ephemeralResources []func() ephemeral.EphemeralResource
+
+	for _, sp := range p.Primary.Meta().(*conns.AWSClient).ServicePackages {
+		v, ok := sp.(conns.ServicePackageWithEphemeralResources)
+		if !ok {
+			continue
+		}
+
+		servicePackageName := sp.ServicePackageName()
+
+		for _, v := range v.EphemeralResources(ctx) {
+			v := v
+			inner, err := v.Factory(ctx)
+
+			if err != nil {
+				errs = append(errs, fmt.Errorf("creating ephemeral resource: %w", err))
+				continue
+			}
+
+			// bootstrapContext is run on all wrapped methods before any interceptors.
+			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
+				ctx = conns.NewEphemeralResourceContext(ctx, servicePackageName, v.Name)
+				if meta != nil {
+					ctx = meta.RegisterLogger(ctx)
+				}
+
+				return ctx
+			}
+
+			ephemeralResources = append(ephemeralResources, func() ephemeral.EphemeralResource {
+				return newWrappedEphemeralResource(bootstrapContext, inner)
+			})
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		tflog.Warn(ctx, "registering ephemeral resources", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	return ephemeralResources
+}
+
+// Actions returns a slice of functions to instantiate each Action
+// implementation.
+//
+// The action type name is determined by the Action implementing
+// the Metadata method. All actions must have unique names.
+func (p *fwprovider) Actions(ctx context.Context) []func() action.Action {
+	var errs []error
+	var actions []func() action.Action
+
+	for _, sp := range p.Primary.Meta().(*conns.AWSClient).ServicePackages {
+		v, ok := sp.(conns.ServicePackageWithActions)
+		if !ok {
+			continue
+		}
+
+		servicePackageName := sp.ServicePackageName()
+
+		for _, v := range v.Actions(ctx) {
+			v := v
+			inner, err := v.Factory(ctx)
+
+			if err != nil {
+				errs = append(errs, fmt.Errorf("creating action: %w", err))
+				continue
+			}
+
+			// bootstrapContext is run on all wrapped methods before any interceptors.
+			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
+				ctx = conns.NewActionContext(ctx, servicePackageName, v.Name)
+				if meta != nil {
+					ctx = meta.RegisterLogger(ctx)
+				}
+
+				return ctx
+			}
+
+			actions = append(actions, func() action.Action {
+				return newWrappedAction(bootstrapContext, inner)
+			})
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		tflog.Warn(ctx, "registering actions", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	return actions
+}
+
+// ListResources returns a slice of functions to instantiate each ListResource
+// implementation.
+//
+// The list resource type name is determined by the ListResource implementing
+// the Metadata method. All list resources must have unique names.
+// Each list resource lists the objects managed by the Plugin SDK resource of the same type name,
+// which must have opted in to resource identity.
+func (p *fwprovider) ListResources(ctx context.Context) []func() list.ListResource {
+	var errs []error
+	var listResources []func() list.ListResource
+
+	primary, ok := p.Primary.(*sdkschema.Provider)
+	if !ok {
+		return listResources
+	}
+
+	for _, sp := range p.Primary.Meta().(*conns.AWSClient).ServicePackages {
+		v, ok := sp.(conns.ServicePackageWithSDKListResources)
+		if !ok {
+			continue
+		}
+
+		servicePackageName := sp.ServicePackageName()
+
+		for _, v := range v.SDKListResources(ctx) {
+			v := v
+			typeName := v.TypeName
+
+			r, ok := primary.ResourcesMap[typeName]
+			if !ok {
+				errs = append(errs, fmt.Errorf("no managed resource defined: %s", typeName))
+				continue
+			}
+			if r.Identity == nil {
+				errs = append(errs, fmt.Errorf("managed resource has no identity: %s", typeName))
+				continue
+			}
+
+			inner, err := v.Factory(ctx)
+
+			if err != nil {
+				errs = append(errs, fmt.Errorf("creating list resource: %w", err))
+				continue
+			}
+
+			if v, ok := inner.(interface{ SetResourceSchema(*sdkschema.Resource) }); ok {
+				v.SetResourceSchema(r)
+			}
+
+			// bootstrapContext is run on all wrapped methods before any interceptors.
+			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
+				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+				if meta != nil {
+					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig, meta.IgnoreTagsConfig)
+					ctx = meta.RegisterLogger(ctx)
+				}
+
+				return ctx
+			}
+
+			// Regional list resources have a per-resource `region` argument.
+			perResourceRegion := !names.IsGlobal(servicePackageName)
+
+			listResources = append(listResources, func() list.ListResource {
+				return newWrappedListResource(bootstrapContext, inner, perResourceRegion)
+			})
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		tflog.Warn(ctx, "registering list resources", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	return listResources
+}
+
 // Functions returns a slice of functions to instantiate each Function
 // implementation.
 //
@@ -466,6 +821,10 @@ func (p *fwprovider) Functions(_ context.Context) []func() function.Function {
 	return []func() function.Function{
 		tffunction.NewARNBuildFunction,
 		tffunction.NewARNParseFunction,
+		tffunction.NewCIDRSubnetsByAZFunction,
+		tffunction.NewPolicyNormalizeFunction,
+		tffunction.NewRegionInfoFunction,
+		tffunction.NewServicePrincipalFunction,
 		tffunction.NewTrimIAMRolePathFunction,
 	}
 }
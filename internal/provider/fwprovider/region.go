@@ -0,0 +1,301 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"maps"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// regionValidators returns the validators for the per-resource `region` argument.
+func regionValidators() []validator.String {
+	return []validator.String{
+		stringvalidator.RegexMatches(regexache.MustCompile(`^[a-z]{2}(-[a-z]+)+-\d$`), "must be a valid AWS Region Code"),
+	}
+}
+
+// regionDataSourceSchemaAttribute returns the schema for a data source's per-resource `region` argument.
+func regionDataSourceSchemaAttribute() dsschema.StringAttribute {
+	return dsschema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Validators:  regionValidators(),
+		Description: "The AWS Region to use for API operations. Defaults to the Region set in the provider configuration.",
+	}
+}
+
+// regionResourceSchemaAttribute returns the schema for a resource's per-resource `region` argument.
+func regionResourceSchemaAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		Validators:  regionValidators(),
+		Description: "The AWS Region in which the resource is managed. Defaults to the Region set in the provider configuration.",
+	}
+}
+
+// regionListResourceSchemaAttribute returns the schema for a list resource's per-resource `region` argument.
+func regionListResourceSchemaAttribute() listschema.StringAttribute {
+	return listschema.StringAttribute{
+		Optional:    true,
+		Validators:  regionValidators(),
+		Description: "The AWS Region in which to list resources. Defaults to the Region set in the provider configuration.",
+	}
+}
+
+// withoutRegion returns a copy of the specified object value with any `region` attribute removed.
+// The per-resource `region` argument is not part of a data source or resource's own schema (or data model)
+// so the attribute is removed before calling the inner data source or resource.
+func withoutRegion(v tftypes.Value) tftypes.Value {
+	t, ok := v.Type().(tftypes.Object)
+	if !ok {
+		return v
+	}
+
+	attributeTypes := maps.Clone(t.AttributeTypes)
+	delete(attributeTypes, names.AttrRegion)
+	typ := tftypes.Object{AttributeTypes: attributeTypes}
+
+	switch {
+	case !v.IsKnown():
+		return tftypes.NewValue(typ, tftypes.UnknownValue)
+	case v.IsNull():
+		return tftypes.NewValue(typ, nil)
+	}
+
+	var m map[string]tftypes.Value
+	if err := v.As(&m); err != nil {
+		return v
+	}
+	delete(m, names.AttrRegion)
+
+	return tftypes.NewValue(typ, m)
+}
+
+// withRegion returns a copy of the specified object value with the `region` attribute added.
+func withRegion(v tftypes.Value, region tftypes.Value) tftypes.Value {
+	t, ok := v.Type().(tftypes.Object)
+	if !ok {
+		return v
+	}
+
+	attributeTypes := maps.Clone(t.AttributeTypes)
+	attributeTypes[names.AttrRegion] = tftypes.String
+	typ := tftypes.Object{AttributeTypes: attributeTypes}
+
+	switch {
+	case !v.IsKnown():
+		return tftypes.NewValue(typ, tftypes.UnknownValue)
+	case v.IsNull():
+		return tftypes.NewValue(typ, nil)
+	}
+
+	var m map[string]tftypes.Value
+	if err := v.As(&m); err != nil {
+		return v
+	}
+	m[names.AttrRegion] = region
+
+	return tftypes.NewValue(typ, m)
+}
+
+// regionValue returns the value of the specified object value's `region` attribute.
+func regionValue(v tftypes.Value) tftypes.Value {
+	if v.Type() != nil && v.IsKnown() && !v.IsNull() {
+		var m map[string]tftypes.Value
+		if err := v.As(&m); err == nil {
+			if region, ok := m[names.AttrRegion]; ok {
+				return region
+			}
+		}
+	}
+
+	return tftypes.NewValue(tftypes.String, nil)
+}
+
+// regionFromAttribute returns any per-resource Region from the specified Config, Plan or State.
+// Unknown values are ignored.
+func regionFromAttribute(ctx context.Context, getAttribute func(context.Context, path.Path, any) diag.Diagnostics, meta *conns.AWSClient) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var region fwtypes.String
+	diags.Append(getAttribute(ctx, path.Root(names.AttrRegion), &region)...)
+	if diags.HasError() {
+		return "", diags
+	}
+
+	if region.IsNull() || region.IsUnknown() || region.ValueString() == "" {
+		return "", diags
+	}
+
+	if err := meta.ValidateRegion(region.ValueString()); err != nil {
+		diags.AddAttributeError(path.Root(names.AttrRegion), "Invalid Region", err.Error())
+		return "", diags
+	}
+
+	return region.ValueString(), diags
+}
+
+// setRegion sets the `region` attribute in State to the Region for the current operation.
+func setRegion(ctx context.Context, state *tfsdk.State, meta *conns.AWSClient) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Resource has been removed from state.
+	if state.Raw.IsNull() {
+		return diags
+	}
+
+	diags.Append(state.SetAttribute(ctx, path.Root(names.AttrRegion), meta.Region(ctx))...)
+
+	return diags
+}
+
+// regionDataSourceInterceptor implements the per-resource `region` argument for data sources.
+type regionDataSourceInterceptor struct{}
+
+func (r regionDataSourceInterceptor) read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		region, d := regionFromAttribute(ctx, request.Config.GetAttribute, meta)
+		diags.Append(d...)
+		if diags.HasError() {
+			return ctx, diags
+		}
+
+		if region != "" {
+			ctx = conns.WithRegion(ctx, region)
+		}
+	case After:
+		diags.Append(setRegion(ctx, &response.State, meta)...)
+	}
+
+	return ctx, diags
+}
+
+// regionResourceInterceptor implements the per-resource `region` argument for resources.
+// Before any CRUD operation the per-resource Region is placed in Context, scoping AWS API clients to that Region.
+type regionResourceInterceptor struct{}
+
+func (r regionResourceInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		return r.before(ctx, request.Plan.GetAttribute, meta, diags)
+	case After:
+		diags.Append(setRegion(ctx, &response.State, meta)...)
+	}
+
+	return ctx, diags
+}
+
+func (r regionResourceInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		return r.before(ctx, request.State.GetAttribute, meta, diags)
+	case After:
+		diags.Append(setRegion(ctx, &response.State, meta)...)
+	}
+
+	return ctx, diags
+}
+
+func (r regionResourceInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		return r.before(ctx, request.Plan.GetAttribute, meta, diags)
+	case After:
+		diags.Append(setRegion(ctx, &response.State, meta)...)
+	}
+
+	return ctx, diags
+}
+
+func (r regionResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		return r.before(ctx, request.State.GetAttribute, meta, diags)
+	}
+
+	return ctx, diags
+}
+
+// modifyPlan plans the resource's per-resource Region.
+// If `region` is not configured the resource is managed in the provider configured Region.
+func (r regionResourceInterceptor) modifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		// Resource is being destroyed.
+		if request.Plan.Raw.IsNull() {
+			return ctx, diags
+		}
+
+		var configRegion fwtypes.String
+		diags.Append(request.Config.GetAttribute(ctx, path.Root(names.AttrRegion), &configRegion)...)
+		if diags.HasError() {
+			return ctx, diags
+		}
+
+		if configRegion.IsNull() {
+			providerRegion := meta.Region(ctx)
+
+			// Resources in state before the `region` argument was added have no Region in state.
+			// Their Region is set without forcing replacement.
+			if !request.State.Raw.IsNull() {
+				var stateRegion fwtypes.String
+				diags.Append(request.State.GetAttribute(ctx, path.Root(names.AttrRegion), &stateRegion)...)
+				if diags.HasError() {
+					return ctx, diags
+				}
+
+				if v := stateRegion.ValueString(); v != "" && v != providerRegion {
+					response.RequiresReplace = append(response.RequiresReplace, path.Root(names.AttrRegion))
+				}
+			}
+
+			diags.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrRegion), providerRegion)...)
+			if diags.HasError() {
+				return ctx, diags
+			}
+		}
+
+		return r.before(ctx, response.Plan.GetAttribute, meta, diags)
+	}
+
+	return ctx, diags
+}
+
+// before places any per-resource Region in Context.
+func (r regionResourceInterceptor) before(ctx context.Context, getAttribute func(context.Context, path.Path, any) diag.Diagnostics, meta *conns.AWSClient, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	region, d := regionFromAttribute(ctx, getAttribute, meta)
+	diags.Append(d...)
+	if diags.HasError() {
+		return ctx, diags
+	}
+
+	if region != "" {
+		ctx = conns.WithRegion(ctx, region)
+	}
+
+	return ctx, diags
+}
@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/retrypolicy"
+)
+
+// retryPolicyResourceInterceptor implements the `retry_policy` nested block for resources.
+// Before any CRUD operation the configured retry policy is placed in Context, where it is honored by the retry helpers.
+type retryPolicyResourceInterceptor struct{}
+
+func (r retryPolicyResourceInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		return r.before(ctx, request.Plan.GetAttribute, diags)
+	}
+
+	return ctx, diags
+}
+
+func (r retryPolicyResourceInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		return r.before(ctx, request.State.GetAttribute, diags)
+	}
+
+	return ctx, diags
+}
+
+func (r retryPolicyResourceInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		return r.before(ctx, request.Plan.GetAttribute, diags)
+	}
+
+	return ctx, diags
+}
+
+func (r retryPolicyResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		return r.before(ctx, request.State.GetAttribute, diags)
+	}
+
+	return ctx, diags
+}
+
+func (r retryPolicyResourceInterceptor) modifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+// before places any configured retry policy in Context.
+func (r retryPolicyResourceInterceptor) before(ctx context.Context, getAttribute func(context.Context, path.Path, any) diag.Diagnostics, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	policy, ok, d := framework.GetRetryPolicy(ctx, getAttribute)
+	diags.Append(d...)
+	if diags.HasError() {
+		return ctx, diags
+	}
+
+	if ok {
+		ctx = retrypolicy.WithRetryPolicy(ctx, policy)
+	}
+
+	return ctx, diags
+}
@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+)
+
+// tracingDataSourceInterceptor records each Read operation as a span when tracing is configured.
+// AWS API calls made by the operation using AWS SDK for Go v2 clients are recorded as child spans.
+type tracingDataSourceInterceptor struct {
+	typeName string
+}
+
+func (r tracingDataSourceInterceptor) read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return traceOperation(ctx, meta, r.typeName, "Read", when, diags), diags
+}
+
+// tracingResourceInterceptor records each CRUD operation as a span when tracing is configured.
+// AWS API calls made by the operation using AWS SDK for Go v2 clients are recorded as child spans.
+type tracingResourceInterceptor struct {
+	typeName string
+}
+
+func (r tracingResourceInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return traceOperation(ctx, meta, r.typeName, "Create", when, diags), diags
+}
+
+func (r tracingResourceInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return traceOperation(ctx, meta, r.typeName, "Read", when, diags), diags
+}
+
+func (r tracingResourceInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return traceOperation(ctx, meta, r.typeName, "Update", when, diags), diags
+}
+
+func (r tracingResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return traceOperation(ctx, meta, r.typeName, "Delete", when, diags), diags
+}
+
+func (r tracingResourceInterceptor) modifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+// traceOperation starts the operation's span before the handler runs and ends it once all other interceptors have run.
+func traceOperation(ctx context.Context, meta *conns.AWSClient, typeName, operation string, when when, diags diag.Diagnostics) context.Context {
+	if meta == nil {
+		return ctx
+	}
+
+	switch when {
+	case Before:
+		ctx = meta.StartOperationSpan(ctx, typeName, operation)
+	case Finally:
+		meta.EndOperationSpan(ctx, fwdiag.DiagnosticsError(diags))
+	}
+
+	return ctx
+}
@@ -0,0 +1,182 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// identitySchema returns the identity schema for a resource with the specified identity.
+func identitySchema(identity *types.ServicePackageResourceIdentity) func() map[string]*schema.Schema {
+	return func() map[string]*schema.Schema {
+		s := map[string]*schema.Schema{
+			names.AttrAccountID: {
+				Type:              schema.TypeString,
+				OptionalForImport: true,
+			},
+		}
+
+		if !identity.Global {
+			s[names.AttrRegion] = &schema.Schema{
+				Type:              schema.TypeString,
+				OptionalForImport: true,
+			}
+		}
+
+		for _, attr := range identity.Attributes {
+			s[attr] = &schema.Schema{
+				Type:              schema.TypeString,
+				RequiredForImport: true,
+			}
+		}
+
+		return s
+	}
+}
+
+// validateIdentityAttributes ensures that a resource's natural key attributes are suitable for use in its identity.
+func validateIdentityAttributes(s map[string]*schema.Schema, identity *types.ServicePackageResourceIdentity) error {
+	for _, attr := range identity.Attributes {
+		switch attr {
+		case names.AttrAccountID, names.AttrRegion:
+			return fmt.Errorf("`%s` cannot be an identity attribute", attr)
+		case names.AttrID:
+			// The resource ID is always present.
+			continue
+		}
+
+		v, ok := s[attr]
+		if !ok {
+			return fmt.Errorf("no `%s` attribute defined in schema", attr)
+		}
+		if v.Type != schema.TypeString {
+			return fmt.Errorf("identity attribute `%s` must be a string", attr)
+		}
+	}
+
+	return nil
+}
+
+// identityResourceInterceptor implements resource identity for resources.
+type identityResourceInterceptor struct {
+	identity          *types.ServicePackageResourceIdentity
+	perResourceRegion bool // Does the resource have a per-resource `region` argument?
+}
+
+func (r identityResourceInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if r.identity == nil {
+		return ctx, diags
+	}
+
+	switch when {
+	case After:
+		switch why {
+		case Create, Read, Update:
+			// Resource has been removed from state.
+			if d.Id() == "" {
+				return ctx, diags
+			}
+
+			v, ok := d.(interface {
+				Identity() (*schema.IdentityData, error)
+			})
+			if !ok {
+				return ctx, diags
+			}
+
+			identity, err := v.Identity()
+			if err != nil {
+				return ctx, sdkdiag.AppendFromErr(diags, err)
+			}
+
+			c := meta.(*conns.AWSClient)
+
+			if err := identity.Set(names.AttrAccountID, c.AccountID); err != nil {
+				return ctx, sdkdiag.AppendErrorf(diags, "setting identity %s: %s", names.AttrAccountID, err)
+			}
+
+			if !r.identity.Global {
+				if err := identity.Set(names.AttrRegion, c.Region(ctx)); err != nil {
+					return ctx, sdkdiag.AppendErrorf(diags, "setting identity %s: %s", names.AttrRegion, err)
+				}
+			}
+
+			for _, attr := range r.identity.Attributes {
+				if err := identity.Set(attr, identityAttributeValue(d, attr)); err != nil {
+					return ctx, sdkdiag.AppendErrorf(diags, "setting identity %s: %s", attr, err)
+				}
+			}
+		}
+	}
+
+	return ctx, diags
+}
+
+// identityAttributeValue returns the value of the specified natural key attribute.
+func identityAttributeValue(d schemaResourceData, attr string) any {
+	if attr == names.AttrID {
+		return d.Id()
+	}
+
+	return d.Get(attr)
+}
+
+// importState wraps a resource's importer so that the resource can be imported by identity.
+// The import ID is the natural key attribute values joined by the resource ID separator.
+func (r identityResourceInterceptor) importState(f schema.StateContextFunc) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+		// Imported by ID.
+		if d.Id() != "" {
+			return f(ctx, d, meta)
+		}
+
+		identity, err := d.Identity()
+		if err != nil {
+			return nil, err
+		}
+
+		c := meta.(*conns.AWSClient)
+
+		if v, ok := identity.GetOk(names.AttrAccountID); ok && v.(string) != c.AccountID {
+			return nil, fmt.Errorf("identity %s (%s) does not match provider AWS account ID (%s)", names.AttrAccountID, v, c.AccountID)
+		}
+
+		if !r.identity.Global {
+			if v, ok := identity.GetOk(names.AttrRegion); ok && v.(string) != c.Region(ctx) {
+				// The identity's Region selects the resource's per-resource Region.
+				if !r.perResourceRegion {
+					return nil, fmt.Errorf("identity %s (%s) does not match provider Region (%s)", names.AttrRegion, v, c.Region(ctx))
+				}
+
+				if err := d.Set(names.AttrRegion, v); err != nil {
+					return nil, fmt.Errorf("setting %s: %w", names.AttrRegion, err)
+				}
+			}
+		}
+
+		parts := make([]string, 0, len(r.identity.Attributes))
+		for _, attr := range r.identity.Attributes {
+			v, ok := identity.GetOk(attr)
+			if !ok {
+				return nil, fmt.Errorf("identity %s not set", attr)
+			}
+
+			parts = append(parts, v.(string))
+		}
+
+		d.SetId(strings.Join(parts, flex.ResourceIdSeparator))
+
+		return f(ctx, d, meta)
+	}
+}
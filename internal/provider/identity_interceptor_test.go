@@ -0,0 +1,289 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testIdentityResource(identity *types.ServicePackageResourceIdentity) *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"parent": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			names.AttrRegion: regionResourceSchema(),
+			"size": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+		},
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: identitySchema(identity),
+		},
+	}
+}
+
+func TestIdentitySchema(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		identity *types.ServicePackageResourceIdentity
+		expected []string
+	}{
+		"regional": {
+			identity: &types.ServicePackageResourceIdentity{
+				Attributes: []string{names.AttrName},
+			},
+			expected: []string{names.AttrAccountID, names.AttrName, names.AttrRegion},
+		},
+		"global": {
+			identity: &types.ServicePackageResourceIdentity{
+				Attributes: []string{names.AttrName},
+				Global:     true,
+			},
+			expected: []string{names.AttrAccountID, names.AttrName},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			r := testIdentityResource(testCase.identity)
+
+			if err := r.Identity.InternalIdentityValidate(); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			s := r.Identity.SchemaMap()
+			if got, want := len(s), len(testCase.expected); got != want {
+				t.Errorf("length of identity schema = %v, want %v", got, want)
+			}
+			for _, k := range testCase.expected {
+				if _, ok := s[k]; !ok {
+					t.Errorf("identity schema missing %q", k)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateIdentityAttributes(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attributes []string
+		wantErr    bool
+	}{
+		"valid": {
+			attributes: []string{"parent", names.AttrName},
+		},
+		"id": {
+			attributes: []string{names.AttrID},
+		},
+		"missing": {
+			attributes: []string{"missing"},
+			wantErr:    true,
+		},
+		"not string": {
+			attributes: []string{"size"},
+			wantErr:    true,
+		},
+		"reserved": {
+			attributes: []string{names.AttrRegion},
+			wantErr:    true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			identity := &types.ServicePackageResourceIdentity{
+				Attributes: testCase.attributes,
+			}
+			r := testIdentityResource(&types.ServicePackageResourceIdentity{})
+
+			err := validateIdentityAttributes(r.SchemaMap(), identity)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Errorf("validateIdentityAttributes error = %v, want error %t", err, want)
+			}
+		})
+	}
+}
+
+func TestIdentityResourceInterceptor(t *testing.T) {
+	t.Parallel()
+
+	ctx := conns.WithRegion(conns.NewResourceContext(context.Background(), "logs", "Log Group"), "us-west-2") //lintignore:AWSAT003
+	identity := &types.ServicePackageResourceIdentity{
+		Attributes: []string{names.AttrName},
+	}
+	interceptor := identityResourceInterceptor{
+		identity: identity,
+	}
+	meta := &conns.AWSClient{
+		AccountID: "123456789012",
+	}
+
+	d := testIdentityResource(identity).TestResourceData()
+	d.SetId("example")
+	d.Set(names.AttrName, "example")
+
+	_, diags := interceptor.run(ctx, d, meta, After, Read, diag.Diagnostics{})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	v, err := d.Identity()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for k, want := range map[string]string{
+		names.AttrAccountID: "123456789012",
+		names.AttrName:      "example",
+		names.AttrRegion:    "us-west-2", //lintignore:AWSAT003
+	} {
+		if got := v.Get(k); got != want {
+			t.Errorf("identity %s = %v, want %v", k, got, want)
+		}
+	}
+}
+
+func TestIdentityResourceInterceptorImportState(t *testing.T) {
+	t.Parallel()
+
+	// The provider configured Region.
+	ctx := conns.WithRegion(conns.NewResourceContext(context.Background(), "logs", "Log Group"), "us-west-2") //lintignore:AWSAT003
+	meta := &conns.AWSClient{
+		AccountID: "123456789012",
+	}
+
+	testCases := map[string]struct {
+		identity          *types.ServicePackageResourceIdentity
+		perResourceRegion bool
+		id                string
+		values            map[string]string
+		expectedID        string
+		expectedRegion    string
+		wantErr           bool
+	}{
+		"import by ID": {
+			identity: &types.ServicePackageResourceIdentity{
+				Attributes: []string{names.AttrName},
+			},
+			id:         "example",
+			expectedID: "example",
+		},
+		"single attribute": {
+			identity: &types.ServicePackageResourceIdentity{
+				Attributes: []string{names.AttrName},
+			},
+			values: map[string]string{
+				names.AttrName: "example",
+			},
+			expectedID: "example",
+		},
+		"multiple attributes": {
+			identity: &types.ServicePackageResourceIdentity{
+				Attributes: []string{"parent", names.AttrName},
+			},
+			values: map[string]string{
+				names.AttrAccountID: "123456789012",
+				names.AttrName:      "example",
+				"parent":            "parent",
+				names.AttrRegion:    "us-west-2", //lintignore:AWSAT003
+			},
+			expectedID: "parent,example",
+		},
+		"account ID mismatch": {
+			identity: &types.ServicePackageResourceIdentity{
+				Attributes: []string{names.AttrName},
+			},
+			values: map[string]string{
+				names.AttrAccountID: "210987654321",
+				names.AttrName:      "example",
+			},
+			wantErr: true,
+		},
+		"Region mismatch": {
+			identity: &types.ServicePackageResourceIdentity{
+				Attributes: []string{names.AttrName},
+			},
+			values: map[string]string{
+				names.AttrName:   "example",
+				names.AttrRegion: "eu-west-1", //lintignore:AWSAT003
+			},
+			wantErr: true,
+		},
+		"per-resource Region": {
+			identity: &types.ServicePackageResourceIdentity{
+				Attributes: []string{names.AttrName},
+			},
+			perResourceRegion: true,
+			values: map[string]string{
+				names.AttrName:   "example",
+				names.AttrRegion: "eu-west-1", //lintignore:AWSAT003
+			},
+			expectedID:     "example",
+			expectedRegion: "eu-west-1", //lintignore:AWSAT003
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			interceptor := identityResourceInterceptor{
+				identity:          testCase.identity,
+				perResourceRegion: testCase.perResourceRegion,
+			}
+			d := testIdentityResource(testCase.identity).TestResourceData()
+			d.SetId(testCase.id)
+
+			identity, err := d.Identity()
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			for k, v := range testCase.values {
+				if err := identity.Set(k, v); err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+			}
+
+			_, err = interceptor.importState(schema.ImportStatePassthroughContext)(ctx, d, meta)
+
+			if testCase.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if got, want := d.Id(), testCase.expectedID; got != want {
+				t.Errorf("ID = %q, want %q", got, want)
+			}
+			if got, want := d.Get(names.AttrRegion).(string), testCase.expectedRegion; got != want {
+				t.Errorf("Region = %q, want %q", got, want)
+			}
+		})
+	}
+}
@@ -5,6 +5,8 @@ package provider
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/go-cty/cty"
@@ -102,7 +104,11 @@ func interceptedHandler[F ~func(context.Context, *schema.ResourceData, any) diag
 				ctx, diags = v.interceptor.run(ctx, d, meta, when, why, diags)
 
 				// Short circuit if any Before interceptor errors.
+				// Finally interceptors aren't run, so end the operation span started by the tracing interceptor here.
 				if diags.HasError() {
+					if c, ok := meta.(*conns.AWSClient); ok {
+						c.EndOperationSpan(ctx, sdkdiag.DiagnosticsError(diags))
+					}
 					return diags
 				}
 			}
@@ -200,10 +206,37 @@ type tagsCRUDFunc func(context.Context, schemaResourceData, conns.ServicePackage
 // tagsResourceInterceptor implements transparent tagging for resources.
 type tagsResourceInterceptor struct {
 	tags       *types.ServicePackageResourceTags
+	typeName   string
 	updateFunc tagsCRUDFunc
 	readFunc   tagsCRUDFunc
 }
 
+// customizeDiff validates the resource's planned tags against any provider configured tag_policy.
+func (r tagsResourceInterceptor) customizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if r.tags == nil {
+		return nil
+	}
+
+	c, ok := meta.(*conns.AWSClient)
+	if !ok || c.TagPolicyConfig == nil {
+		return nil
+	}
+
+	// Tags that are unknown during plan are validated during apply.
+	if v := d.GetRawPlan(); !v.IsNull() && !v.GetAttr(names.AttrTags).IsWhollyKnown() {
+		return nil
+	}
+
+	tags := tftags.New(ctx, d.Get(names.AttrTags).(map[string]interface{}))
+
+	var errs []error
+	for _, v := range c.TagPolicyConfig.Validate(c.DefaultTagsConfig, c.IgnoreTagsConfig, tags) {
+		errs = append(errs, fmt.Errorf("%s does not comply with tag_policy: %w", r.typeName, v))
+	}
+
+	return errors.Join(errs...)
+}
+
 func (r tagsResourceInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
 	if r.tags == nil {
 		return ctx, diags
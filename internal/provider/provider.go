@@ -8,6 +8,8 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"regexp"
+	"slices"
 	"strings"
 	"time"
 
@@ -18,12 +20,15 @@ import (
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2/types/nullable"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -124,6 +129,21 @@ func New(ctx context.Context) (*schema.Provider, error) {
 							Elem:        &schema.Schema{Type: schema.TypeString},
 							Description: "Resource tag key prefixes to ignore across all resources.",
 						},
+						"key_regexes": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsValidRegExp,
+							},
+							Description: "Regular expressions matching resource tag keys to ignore across all resources.",
+						},
+						"key_value_pairs": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Resource tags to ignore across all resources only if both the tag key and value match.",
+						},
 					},
 				},
 			},
@@ -185,6 +205,60 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The secret key for API operations. You can retrieve this\n" +
 					"from the 'Security & Credentials' section of the AWS console.",
 			},
+			"service_concurrency": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Configuration block with settings to limit the number of concurrent API operations for a service.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"limit": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "The maximum number of concurrent API operations.",
+						},
+						"operations": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The API operations that share the limit. If not set, the limit is shared by all of the service's operations.",
+						},
+						"service": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(names.Aliases(), false),
+							Description:  "The service, as used in the `endpoints` configuration block.",
+						},
+					},
+				},
+			},
+			"service_limits": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Configuration block with settings to override the retry settings for a service.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_retries": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "The maximum number of times an AWS API request to the service is retried.",
+						},
+						"service": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(names.Aliases(), false),
+							Description:  "The service, as used in the `endpoints` configuration block.",
+						},
+						"token_bucket_rate_limiter_capacity": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "The capacity of the token bucket rate limiter shared by the service's API clients.",
+						},
+					},
+				},
+			},
 			"shared_config_files": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -228,6 +302,54 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The region where AWS STS operations will take place. Examples\n" +
 					"are us-east-1 and us-west-2.", // lintignore:AWSAT003,
 			},
+			"tag_policy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to validate resource tags across all resources.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed_values": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "Regular expressions that resource tag values must match.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrKey: {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Resource tag key.",
+									},
+									"pattern": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsValidRegExp,
+										Description:  "Regular expression that the entire resource tag value must match.",
+									},
+								},
+							},
+						},
+						"key_case": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: enum.Validate[tftags.Case](),
+							Description:      "Case that resource tag keys must use. Valid values are `camel`, `lower`, `pascal` and `upper`.",
+						},
+						"required_keys": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Resource tag keys that must be set on all resources.",
+						},
+						"value_case": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: enum.Validate[tftags.Case](),
+							Description:      "Case that resource tag values must use. Valid values are `camel`, `lower`, `pascal` and `upper`.",
+						},
+					},
+				},
+			},
 			"token": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -239,6 +361,33 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Optional:    true,
 				Description: "The capacity of the AWS SDK's token bucket rate limiter.",
 			},
+			"tracing": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to export OpenTelemetry traces of resource operations and AWS API calls.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrEndpoint: {
+							Type:          schema.TypeString,
+							Optional:      true,
+							ConflictsWith: []string{"tracing.0.file"},
+							Description:   "The OTLP/HTTP collector endpoint to export traces to, e.g. `localhost:4318`.",
+						},
+						"file": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							ConflictsWith: []string{"tracing.0.endpoint"},
+							Description:   "The path of a file to append traces to in OTLP JSON format.",
+						},
+						"insecure": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Whether to export traces to the collector endpoint using HTTP rather than HTTPS.",
+						},
+					},
+				},
+			},
 			"use_dualstack_endpoint": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -296,7 +445,23 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 				return ctx
 			}
-			interceptors := interceptorItems{}
+			interceptors := interceptorItems{
+				// Tracing must be the first interceptor so that its span covers all others.
+				{
+					when:        Before | Finally,
+					why:         Read,
+					interceptor: tracingInterceptor{typeName: typeName},
+				},
+			}
+
+			// Regional data sources have a per-resource `region` argument.
+			if !names.IsGlobal(servicePackageName) && injectRegionAttribute(r, regionDataSourceSchema()) {
+				interceptors = append(interceptors, interceptorItem{
+					when:        Before | After,
+					why:         Read,
+					interceptor: regionInterceptor{},
+				})
+			}
 
 			if v.Tags != nil {
 				schema := r.SchemaMap()
@@ -373,7 +538,41 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 				return ctx
 			}
-			interceptors := interceptorItems{}
+			interceptors := interceptorItems{
+				// Tracing must be the first interceptor so that its span covers all others.
+				{
+					when:        Before | Finally,
+					why:         AllOps,
+					interceptor: tracingInterceptor{typeName: typeName},
+				},
+			}
+
+			// Regional resources have a per-resource `region` argument.
+			perResourceRegion := !names.IsGlobal(servicePackageName) && injectRegionAttribute(r, regionResourceSchema())
+			if perResourceRegion {
+				interceptor := regionInterceptor{}
+				interceptors = append(interceptors, interceptorItem{
+					when:        Before | After,
+					why:         AllOps,
+					interceptor: interceptor,
+				})
+
+				r.CustomizeDiff = interceptor.customizeDiff(r.CustomizeDiff)
+				if v := r.Importer; v != nil {
+					if v := v.StateContext; v != nil {
+						r.Importer.StateContext = interceptor.importState(v)
+					}
+				}
+			}
+
+			// Resources that define the `retry_policy` nested block honor the configured retry policy.
+			if sdkv2.HasRetryPolicySchema(r.SchemaMap()) {
+				interceptors = append(interceptors, interceptorItem{
+					when:        Before,
+					why:         AllOps,
+					interceptor: retryPolicyInterceptor{},
+				})
+			}
 
 			if v.Tags != nil {
 				schema := r.SchemaMap()
@@ -399,15 +598,52 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					continue
 				}
 
+				interceptor := tagsResourceInterceptor{
+					tags:       v.Tags,
+					typeName:   typeName,
+					updateFunc: tagsUpdateFunc,
+					readFunc:   tagsReadFunc,
+				}
 				interceptors = append(interceptors, interceptorItem{
-					when: Before | After | Finally,
-					why:  Create | Read | Update,
-					interceptor: tagsResourceInterceptor{
-						tags:       v.Tags,
-						updateFunc: tagsUpdateFunc,
-						readFunc:   tagsReadFunc,
-					},
+					when:        Before | After | Finally,
+					why:         Create | Read | Update,
+					interceptor: interceptor,
+				})
+
+				// Validate planned tags against any provider configured tag_policy.
+				if v := r.CustomizeDiff; v != nil {
+					r.CustomizeDiff = customdiff.Sequence(interceptor.customizeDiff, v)
+				} else {
+					r.CustomizeDiff = interceptor.customizeDiff
+				}
+			}
+
+			if v := v.Identity; v != nil {
+				// The resource has opted in to resource identity.
+				// Ensure that the schema look OK.
+				if err := validateIdentityAttributes(r.SchemaMap(), v); err != nil {
+					errs = append(errs, fmt.Errorf("%w: %s", err, typeName))
+					continue
+				}
+
+				interceptor := identityResourceInterceptor{
+					identity:          v,
+					perResourceRegion: perResourceRegion,
+				}
+				interceptors = append(interceptors, interceptorItem{
+					when:        After,
+					why:         Create | Read | Update,
+					interceptor: interceptor,
 				})
+
+				r.Identity = &schema.ResourceIdentity{
+					SchemaFunc: identitySchema(v),
+				}
+				if v := r.Importer; v != nil {
+					if v := v.StateContext; v != nil {
+						r.Importer.StateContext = interceptor.importState(v)
+					}
+				}
 			}
 
 			rs := &wrappedResource{
@@ -514,14 +750,25 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.AllowedAccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
-	if v, ok := d.GetOk("assume_role"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		config.AssumeRole = expandAssumeRole(ctx, v.([]interface{})[0].(map[string]interface{}))
-		tflog.Info(ctx, "assume_role configuration set", map[string]any{
-			"tf_aws.assume_role.role_arn":        config.AssumeRole.RoleARN,
-			"tf_aws.assume_role.session_name":    config.AssumeRole.SessionName,
-			"tf_aws.assume_role.external_id":     config.AssumeRole.ExternalID,
-			"tf_aws.assume_role.source_identity": config.AssumeRole.SourceIdentity,
-		})
+	if v, ok := d.GetOk("assume_role"); ok && len(v.([]interface{})) > 0 {
+		for i, tfMapRaw := range v.([]interface{}) {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			// An empty block would silently be skipped, making the hops disagree with the configured order.
+			if !ok {
+				return nil, sdkdiag.AppendErrorf(diags, "assume_role: block %d of %d is empty", i+1, len(v.([]interface{})))
+			}
+
+			assumeRole := expandAssumeRole(ctx, tfMap)
+			config.AssumeRole = append(config.AssumeRole, *assumeRole)
+			tflog.Info(ctx, "assume_role configuration set", map[string]any{
+				"tf_aws.assume_role.hop":             i + 1,
+				"tf_aws.assume_role.role_arn":        assumeRole.RoleARN,
+				"tf_aws.assume_role.session_name":    assumeRole.SessionName,
+				"tf_aws.assume_role.external_id":     assumeRole.ExternalID,
+				"tf_aws.assume_role.source_identity": assumeRole.SourceIdentity,
+			})
+		}
 	}
 
 	if v, ok := d.GetOk("assume_role_with_web_identity"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
@@ -571,6 +818,34 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.MaxRetries = v.(int)
 	}
 
+	if v, ok := d.GetOk("service_concurrency"); ok && len(v.([]interface{})) > 0 {
+		serviceConcurrency, err := expandServiceConcurrency(ctx, v.([]interface{}))
+		if err != nil {
+			return nil, sdkdiag.AppendFromErr(diags, err)
+		}
+		config.ServiceConcurrency = serviceConcurrency
+	}
+
+	if v, ok := d.GetOk("service_limits"); ok && len(v.([]interface{})) > 0 {
+		serviceLimits, err := expandServiceLimits(ctx, v.([]interface{}))
+		if err != nil {
+			return nil, sdkdiag.AppendFromErr(diags, err)
+		}
+		config.ServiceLimits = serviceLimits
+	}
+
+	if v, ok := d.GetOk("tag_policy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tagPolicy, err := expandTagPolicy(ctx, v.([]interface{})[0].(map[string]interface{}))
+		if err != nil {
+			return nil, sdkdiag.AppendFromErr(diags, err)
+		}
+		config.TagPolicyConfig = tagPolicy
+	}
+
+	if v, ok := d.GetOk("tracing"); ok && len(v.([]interface{})) > 0 {
+		config.Tracing = expandTracing(ctx, v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("shared_credentials_files"); ok && len(v.([]interface{})) > 0 {
 		config.SharedCredentialsFiles = flex.ExpandStringValueList(v.([]interface{}))
 	}
@@ -605,9 +880,9 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 
 func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
-		Type:     schema.TypeList,
-		Optional: true,
-		MaxItems: 1,
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "IAM Roles to assume in order, each using the credentials of the previous role.",
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"duration": {
@@ -867,9 +1142,146 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags
 		ignoreConfig.KeyPrefixes = tftags.New(ctx, v.List())
 	}
 
+	if v, ok := tfMap["key_regexes"].(*schema.Set); ok {
+		for _, v := range flex.ExpandStringValueSet(v) {
+			ignoreConfig.KeyRegexes = append(ignoreConfig.KeyRegexes, regexache.MustCompile(v))
+		}
+	}
+
+	if v, ok := tfMap["key_value_pairs"].(map[string]interface{}); ok {
+		ignoreConfig.KeyValuePairs = tftags.New(ctx, v)
+	}
+
 	return ignoreConfig
 }
 
+func expandTracing(_ context.Context, tfList []interface{}) *conns.TracingConfig {
+	tracing := &conns.TracingConfig{}
+
+	// An empty `tracing {}` block exports to the endpoint set by the OTLP exporter environment variables.
+	tfMap, ok := tfList[0].(map[string]interface{})
+	if !ok {
+		return tracing
+	}
+
+	tracing.Endpoint = tfMap[names.AttrEndpoint].(string)
+	tracing.File = tfMap["file"].(string)
+	tracing.Insecure = tfMap["insecure"].(bool)
+
+	return tracing
+}
+
+func expandTagPolicy(_ context.Context, tfMap map[string]interface{}) (*tftags.PolicyConfig, error) {
+	if tfMap == nil {
+		return nil, nil
+	}
+
+	policyConfig := &tftags.PolicyConfig{
+		KeyCase:   tftags.Case(tfMap["key_case"].(string)),
+		ValueCase: tftags.Case(tfMap["value_case"].(string)),
+	}
+
+	if v, ok := tfMap["allowed_values"].(*schema.Set); ok && v.Len() > 0 {
+		policyConfig.AllowedValues = make(map[string]*regexp.Regexp)
+
+		for _, tfMapRaw := range v.List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			key := tfMap[names.AttrKey].(string)
+
+			if _, ok := policyConfig.AllowedValues[key]; ok {
+				return nil, fmt.Errorf("tag_policy: duplicate allowed_values configuration for key %q", key)
+			}
+
+			// The pattern must match the entire tag value.
+			re, err := regexp.Compile(`^(?:` + tfMap["pattern"].(string) + `)$`)
+
+			if err != nil {
+				return nil, fmt.Errorf("tag_policy: allowed_values pattern for key %q: %w", key, err)
+			}
+
+			policyConfig.AllowedValues[key] = re
+		}
+	}
+
+	if v, ok := tfMap["required_keys"].(*schema.Set); ok && v.Len() > 0 {
+		policyConfig.RequiredKeys = flex.ExpandStringValueSet(v)
+		slices.Sort(policyConfig.RequiredKeys)
+	}
+
+	return policyConfig, nil
+}
+
+func expandServiceConcurrency(_ context.Context, tfList []interface{}) (map[string][]conns.ServiceConcurrency, error) {
+	serviceConcurrency := make(map[string][]conns.ServiceConcurrency)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		service := tfMap["service"].(string)
+		pkg, err := names.ProviderPackageForAlias(service)
+
+		if err != nil {
+			return nil, err
+		}
+
+		// Concurrency limits are implemented as AWS SDK for Go v2 middleware.
+		if !names.ClientSDKV2(pkg) {
+			return nil, fmt.Errorf("service_concurrency: service %q does not use an AWS SDK for Go v2 client and its concurrency can't be limited", service)
+		}
+
+		apiObject := conns.ServiceConcurrency{
+			Limit: tfMap["limit"].(int),
+		}
+
+		if v, ok := tfMap["operations"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.Operations = flex.ExpandStringValueSet(v)
+		}
+
+		serviceConcurrency[pkg] = append(serviceConcurrency[pkg], apiObject)
+	}
+
+	return serviceConcurrency, nil
+}
+
+func expandServiceLimits(_ context.Context, tfList []interface{}) (map[string]conns.ServiceLimits, error) {
+	serviceLimits := make(map[string]conns.ServiceLimits)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		service := tfMap["service"].(string)
+		pkg, err := names.ProviderPackageForAlias(service)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := serviceLimits[pkg]; ok {
+			return nil, fmt.Errorf("service_limits: duplicate configuration for service %q", service)
+		}
+
+		serviceLimits[pkg] = conns.ServiceLimits{
+			MaxRetries:                     tfMap["max_retries"].(int),
+			TokenBucketRateLimiterCapacity: tfMap["token_bucket_rate_limiter_capacity"].(int),
+		}
+	}
+
+	return serviceLimits, nil
+}
+
 func expandEndpoints(_ context.Context, tfList []interface{}) (map[string]string, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
@@ -11,6 +11,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
 	sts_sdkv2 "github.com/aws/aws-sdk-go-v2/service/sts"
 	"github.com/aws/aws-sdk-go/aws"
@@ -253,6 +254,85 @@ func TestAccProvider_overrideUseFipsEndpointFlagForOneService(t *testing.T) {
 	})
 }
 
+func TestAccProvider_serviceConcurrency(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_lambda_functions.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig_serviceConcurrency("lambda"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "function_names.#"),
+				),
+			},
+			{
+				Config:      testAccProviderConfig_serviceConcurrency("notaservice"),
+				ExpectError: regexache.MustCompile(`expected .*service to be one of`),
+			},
+		},
+	})
+}
+
+func TestAccProvider_serviceLimits(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_iam_roles.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig_serviceLimits("iam"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "names.#"),
+				),
+			},
+			{
+				Config:      testAccProviderConfig_serviceLimitsDuplicate(),
+				ExpectError: regexache.MustCompile(`duplicate configuration for service`),
+			},
+		},
+	})
+}
+
+func TestAccProvider_tagPolicy(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_log_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderConfig_tagPolicy(rName, "owner", "prod"),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`aws_cloudwatch_log_group does not comply with tag_policy: tag "owner" \(from default_tags\): key must be pascal case`),
+			},
+			{
+				Config:      testAccProviderConfig_tagPolicy(rName, "Owner", "production"),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`aws_cloudwatch_log_group does not comply with tag_policy: tag "Environment" \(from tags\): value "production" does not match`),
+			},
+			{
+				Config: testAccProviderConfig_tagPolicy(rName, "Owner", "prod"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccProvider_IgnoreTags_emptyBlock(t *testing.T) {
 	ctx := acctest.Context(t)
 	var provider *schema.Provider
@@ -604,7 +684,7 @@ func testAccCheckRegion(ctx context.Context, t *testing.T, p **schema.Provider,
 			return fmt.Errorf("provider not initialized")
 		}
 
-		if got := (*p).Meta().(*conns.AWSClient).Region; got != expectedRegion {
+		if got := (*p).Meta().(*conns.AWSClient).Region(ctx); got != expectedRegion {
 			return fmt.Errorf("expected Region (%s), got: %s", expectedRegion, got)
 		}
 
@@ -1077,6 +1157,91 @@ resource "aws_appconfig_application" "test" {
 `, appconfig_endpoint, rName))
 }
 
+func testAccProviderConfig_serviceConcurrency(service string) string {
+	//lintignore:AT004
+	return acctest.ConfigCompose(testAccProviderConfig_base, fmt.Sprintf(`
+provider "aws" {
+  service_concurrency {
+    service = %[1]q
+    limit   = 2
+  }
+
+  service_concurrency {
+    service    = %[1]q
+    operations = ["ListFunctions"]
+    limit      = 1
+  }
+}
+
+data "aws_lambda_functions" "test" {}
+`, service))
+}
+
+func testAccProviderConfig_serviceLimits(service string) string {
+	//lintignore:AT004
+	return acctest.ConfigCompose(testAccProviderConfig_base, fmt.Sprintf(`
+provider "aws" {
+  service_limits {
+    service                            = %[1]q
+    max_retries                        = 50
+    token_bucket_rate_limiter_capacity = 1000
+  }
+}
+
+data "aws_iam_roles" "test" {}
+`, service))
+}
+
+func testAccProviderConfig_serviceLimitsDuplicate() string {
+	//lintignore:AT004
+	return acctest.ConfigCompose(testAccProviderConfig_base, `
+provider "aws" {
+  service_limits {
+    service     = "iam"
+    max_retries = 50
+  }
+
+  service_limits {
+    service     = "iam"
+    max_retries = 10
+  }
+}
+
+data "aws_iam_roles" "test" {}
+`)
+}
+
+func testAccProviderConfig_tagPolicy(rName, ownerKey, environment string) string {
+	//lintignore:AT004
+	return acctest.ConfigCompose(testAccProviderConfig_base, fmt.Sprintf(`
+provider "aws" {
+  default_tags {
+    tags = {
+      %[2]s = "platform"
+    }
+  }
+
+  tag_policy {
+    required_keys = ["Environment"]
+    key_case      = "pascal"
+
+    allowed_values {
+      key     = "Environment"
+      pattern = "dev|prod"
+    }
+  }
+}
+
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+
+  tags = {
+    Environment = %[3]q
+  }
+}
+`, rName, ownerKey, environment))
+}
+
 func testAccProviderConfig_ignoreTagsKeys0() string {
 	//lintignore:AT004
 	return acctest.ConfigCompose(testAccProviderConfig_base, `
@@ -59,6 +59,55 @@ func TestExpandEndpoints(t *testing.T) { //nolint:paralleltest
 	}
 }
 
+func TestExpandServiceConcurrency(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		service   string
+		expectErr bool
+	}{
+		"SDK v2": {
+			service: "lambda",
+		},
+		"SDK v1 only": {
+			service:   "appmesh",
+			expectErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			tfList := []interface{}{
+				map[string]interface{}{
+					"limit":   10,
+					"service": testCase.service,
+				},
+			}
+
+			got, err := expandServiceConcurrency(ctx, tfList)
+
+			if testCase.expectErr {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := len(got[testCase.service]), 1; got != want {
+				t.Errorf("%d limits for %s, want %d", got, testCase.service, want)
+			}
+		})
+	}
+}
+
 func TestEndpointMultipleKeys(t *testing.T) { //nolint:paralleltest
 	ctx := context.Background()
 	testcases := []struct {
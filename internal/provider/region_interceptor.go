@@ -0,0 +1,171 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// regionDataSourceSchema returns the schema for a data source's per-resource `region` argument.
+func regionDataSourceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: verify.ValidRegionName,
+		Description:  "The AWS Region to use for API operations. Defaults to the Region set in the provider configuration.",
+	}
+}
+
+// regionResourceSchema returns the schema for a resource's per-resource `region` argument.
+func regionResourceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ForceNew:     true,
+		ValidateFunc: verify.ValidRegionName,
+		Description:  "The AWS Region in which the resource is managed. Defaults to the Region set in the provider configuration.",
+	}
+}
+
+// injectRegionAttribute adds the per-resource `region` argument to a data source or resource's schema.
+// It returns false if the schema already defines a `region` attribute.
+func injectRegionAttribute(r *schema.Resource, s *schema.Schema) bool {
+	if _, ok := r.SchemaMap()[names.AttrRegion]; ok {
+		return false
+	}
+
+	if f := r.SchemaFunc; f != nil {
+		r.SchemaFunc = func() map[string]*schema.Schema {
+			m := f()
+			m[names.AttrRegion] = s
+			return m
+		}
+	} else {
+		r.Schema[names.AttrRegion] = s
+	}
+
+	return true
+}
+
+// regionFromSchemaResourceData returns any per-resource Region, validating that it is in the provider's partition.
+func regionFromSchemaResourceData(d interface{ Get(string) any }, c *conns.AWSClient) (string, error) {
+	region, _ := d.Get(names.AttrRegion).(string)
+	if region == "" {
+		return "", nil
+	}
+
+	if err := c.ValidateRegion(region); err != nil {
+		return "", err
+	}
+
+	return region, nil
+}
+
+// regionInterceptor implements the per-resource `region` argument for data sources and resources.
+// Before any CRUD operation the per-resource Region is placed in Context, scoping AWS API clients to that Region.
+type regionInterceptor struct{}
+
+func (r regionInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	c := meta.(*conns.AWSClient)
+
+	switch when {
+	case Before:
+		region, err := regionFromSchemaResourceData(d, c)
+		if err != nil {
+			return ctx, sdkdiag.AppendFromErr(diags, err)
+		}
+
+		if region != "" {
+			ctx = conns.WithRegion(ctx, region)
+		}
+	case After:
+		switch why {
+		case Create, Read, Update:
+			// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated.
+			if d.Id() == "" {
+				return ctx, diags
+			}
+
+			if err := d.Set(names.AttrRegion, c.Region(ctx)); err != nil {
+				return ctx, sdkdiag.AppendErrorf(diags, "setting %s: %s", names.AttrRegion, err)
+			}
+		}
+	}
+
+	return ctx, diags
+}
+
+// customizeDiff plans the resource's per-resource Region and runs any CustomizeDiff function with that Region in Context.
+// If `region` is not configured the resource is managed in the provider configured Region.
+func (r regionInterceptor) customizeDiff(f schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+		c := meta.(*conns.AWSClient)
+		providerRegion := c.Region(ctx)
+
+		if d.GetRawConfig().GetAttr(names.AttrRegion).IsNull() {
+			// Resources in state before the `region` argument was added have no Region in state.
+			// Their Region is set on the next refresh, so don't force replacement.
+			if old, _ := d.GetChange(names.AttrRegion); d.Id() == "" || old.(string) != "" {
+				if err := d.SetNew(names.AttrRegion, providerRegion); err != nil {
+					return fmt.Errorf("setting %s: %w", names.AttrRegion, err)
+				}
+			}
+		}
+
+		if f == nil {
+			return nil
+		}
+
+		if d.NewValueKnown(names.AttrRegion) {
+			region, err := regionFromSchemaResourceData(d, c)
+			if err != nil {
+				return err
+			}
+
+			if region != "" {
+				ctx = conns.WithRegion(ctx, region)
+			}
+		}
+
+		return f(ctx, d, meta)
+	}
+}
+
+// importState wraps a resource's importer so that the import ID can specify a per-resource Region,
+// e.g. `my-log-group@eu-west-1`.
+func (r regionInterceptor) importState(f schema.StateContextFunc) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+		c := meta.(*conns.AWSClient)
+
+		if id, region := conns.SplitImportIDRegion(d.Id()); region != "" {
+			d.SetId(id)
+			if err := d.Set(names.AttrRegion, region); err != nil {
+				return nil, fmt.Errorf("setting %s: %w", names.AttrRegion, err)
+			}
+		}
+
+		region, err := regionFromSchemaResourceData(d, c)
+		if err != nil {
+			return nil, err
+		}
+
+		if region != "" {
+			ctx = conns.WithRegion(ctx, region)
+		} else if err := d.Set(names.AttrRegion, c.Region(ctx)); err != nil {
+			return nil, fmt.Errorf("setting %s: %w", names.AttrRegion, err)
+		}
+
+		return f(ctx, d, meta)
+	}
+}
@@ -0,0 +1,228 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testRegionResource() *schema.Resource {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+	injectRegionAttribute(r, regionResourceSchema())
+
+	return r
+}
+
+func TestInjectRegionAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		resource *schema.Resource
+		expected bool
+	}{
+		"Schema": {
+			resource: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					names.AttrName: {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+				},
+			},
+			expected: true,
+		},
+		"SchemaFunc": {
+			resource: &schema.Resource{
+				SchemaFunc: func() map[string]*schema.Schema {
+					return map[string]*schema.Schema{
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					}
+				},
+			},
+			expected: true,
+		},
+		"existing region attribute": {
+			resource: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					names.AttrRegion: {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := injectRegionAttribute(testCase.resource, regionResourceSchema()), testCase.expected; got != want {
+				t.Errorf("injectRegionAttribute = %t, want %t", got, want)
+			}
+
+			v, ok := testCase.resource.SchemaMap()[names.AttrRegion]
+			if !ok {
+				t.Fatalf("no %s attribute in schema", names.AttrRegion)
+			}
+			if got, want := v.Optional, testCase.expected; got != want {
+				t.Errorf("%s Optional = %t, want %t", names.AttrRegion, got, want)
+			}
+		})
+	}
+}
+
+func TestRegionInterceptor(t *testing.T) {
+	t.Parallel()
+
+	// The provider configured Region.
+	ctx := conns.WithRegion(conns.NewResourceContext(context.Background(), "logs", "Log Group"), "us-west-2") //lintignore:AWSAT003
+	meta := &conns.AWSClient{
+		Partition: names.StandardPartitionID,
+	}
+	interceptor := regionInterceptor{}
+
+	testCases := map[string]struct {
+		region         string
+		expectedRegion string
+		wantErr        bool
+	}{
+		"provider Region": {
+			expectedRegion: "us-west-2", //lintignore:AWSAT003
+		},
+		"per-resource Region": {
+			region:         "eu-west-1", //lintignore:AWSAT003
+			expectedRegion: "eu-west-1", //lintignore:AWSAT003
+		},
+		"other partition": {
+			region:  "cn-north-1", //lintignore:AWSAT003
+			wantErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			d := testRegionResource().TestResourceData()
+			d.SetId("example")
+			d.Set(names.AttrRegion, testCase.region)
+
+			ctx, diags := interceptor.run(ctx, d, meta, Before, Read, diag.Diagnostics{})
+
+			if testCase.wantErr {
+				if !diags.HasError() {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if got, want := meta.Region(ctx), testCase.expectedRegion; got != want {
+				t.Errorf("Region = %q, want %q", got, want)
+			}
+
+			d.Set(names.AttrRegion, "")
+			_, diags = interceptor.run(ctx, d, meta, After, Read, diags)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if got, want := d.Get(names.AttrRegion).(string), testCase.expectedRegion; got != want {
+				t.Errorf("%s = %q, want %q", names.AttrRegion, got, want)
+			}
+		})
+	}
+}
+
+func TestRegionInterceptorImportState(t *testing.T) {
+	t.Parallel()
+
+	// The provider configured Region.
+	ctx := conns.WithRegion(conns.NewResourceContext(context.Background(), "logs", "Log Group"), "us-west-2") //lintignore:AWSAT003
+	meta := &conns.AWSClient{
+		Partition: names.StandardPartitionID,
+	}
+	interceptor := regionInterceptor{}
+
+	testCases := map[string]struct {
+		id             string
+		expectedID     string
+		expectedRegion string
+		wantErr        bool
+	}{
+		"no Region": {
+			id:             "example",
+			expectedID:     "example",
+			expectedRegion: "us-west-2", //lintignore:AWSAT003
+		},
+		"Region": {
+			id:             "example@eu-west-1", //lintignore:AWSAT003
+			expectedID:     "example",
+			expectedRegion: "eu-west-1", //lintignore:AWSAT003
+		},
+		"not a Region": {
+			id:             "user@example.com",
+			expectedID:     "user@example.com",
+			expectedRegion: "us-west-2", //lintignore:AWSAT003
+		},
+		"other partition": {
+			id:      "example@cn-north-1", //lintignore:AWSAT003
+			wantErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var importRegion string
+			f := func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+				importRegion = meta.(*conns.AWSClient).Region(ctx)
+				return []*schema.ResourceData{d}, nil
+			}
+
+			d := testRegionResource().TestResourceData()
+			d.SetId(testCase.id)
+
+			_, err := interceptor.importState(f)(ctx, d, meta)
+
+			if testCase.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if got, want := d.Id(), testCase.expectedID; got != want {
+				t.Errorf("ID = %q, want %q", got, want)
+			}
+			if got, want := d.Get(names.AttrRegion).(string), testCase.expectedRegion; got != want {
+				t.Errorf("%s = %q, want %q", names.AttrRegion, got, want)
+			}
+			if got, want := importRegion, testCase.expectedRegion; got != want {
+				t.Errorf("import Region = %q, want %q", got, want)
+			}
+		})
+	}
+}
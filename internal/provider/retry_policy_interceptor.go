@@ -0,0 +1,27 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/retrypolicy"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
+)
+
+// retryPolicyInterceptor implements the `retry_policy` nested block for resources.
+// Before any CRUD operation the configured retry policy is placed in Context, where it is honored by the retry helpers.
+type retryPolicyInterceptor struct{}
+
+func (r retryPolicyInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		if policy, ok := sdkv2.RetryPolicyFromResourceData(d); ok {
+			ctx = retrypolicy.WithRetryPolicy(ctx, policy)
+		}
+	}
+
+	return ctx, diags
+}
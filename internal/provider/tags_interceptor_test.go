@@ -10,10 +10,13 @@ import (
 
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/types/option"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type mockService struct{}
@@ -148,3 +151,124 @@ func (d *resourceData) GetChange(key string) (interface{}, interface{}) {
 func (d *resourceData) HasChange(key string) bool {
 	return false
 }
+
+func TestTagsResourceInterceptorCustomizeDiff(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := map[string]struct {
+		defaultTags map[string]interface{}
+		tags        map[string]interface{}
+		tagPolicy   map[string]interface{}
+		wantErr     string
+	}{
+		"no tag policy": {
+			tags: map[string]interface{}{
+				"owner": "platform",
+			},
+		},
+		"compliant": {
+			defaultTags: map[string]interface{}{
+				"Owner": "platform",
+			},
+			tags: map[string]interface{}{
+				"Environment": "prod",
+			},
+			tagPolicy: map[string]interface{}{
+				"key_case": "pascal",
+				"required_keys": schema.NewSet(schema.HashString, []interface{}{
+					"Environment",
+					"Owner",
+				}),
+			},
+		},
+		"default_tags violation": {
+			defaultTags: map[string]interface{}{
+				"owner": "platform",
+			},
+			tagPolicy: map[string]interface{}{
+				"key_case": "pascal",
+			},
+			wantErr: `aws_test does not comply with tag_policy: tag "owner" (from default_tags): key must be pascal case`,
+		},
+		"tags violation": {
+			tags: map[string]interface{}{
+				"Environment": "production",
+			},
+			tagPolicy: map[string]interface{}{
+				"allowed_values": schema.NewSet(func(v interface{}) int { return schema.HashString(v.(map[string]interface{})["key"]) }, []interface{}{
+					map[string]interface{}{
+						"key":     "Environment",
+						"pattern": "dev|prod",
+					},
+				}),
+			},
+			wantErr: `aws_test does not comply with tag_policy: tag "Environment" (from tags): value "production" does not match allowed pattern "^(?:dev|prod)$"`,
+		},
+		"required key missing": {
+			tagPolicy: map[string]interface{}{
+				"required_keys": schema.NewSet(schema.HashString, []interface{}{
+					"Owner",
+				}),
+			},
+			wantErr: `aws_test does not comply with tag_policy: tag "Owner": required tag is missing from both default_tags and tags`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			meta := &conns.AWSClient{}
+			if testCase.defaultTags != nil {
+				meta.DefaultTagsConfig = expandDefaultTags(ctx, map[string]interface{}{
+					"tags": testCase.defaultTags,
+				})
+			}
+			if testCase.tagPolicy != nil {
+				tagPolicy := map[string]interface{}{
+					"key_case":   "",
+					"value_case": "",
+				}
+				for k, v := range testCase.tagPolicy {
+					tagPolicy[k] = v
+				}
+
+				v, err := expandTagPolicy(ctx, tagPolicy)
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				meta.TagPolicyConfig = v
+			}
+
+			interceptor := tagsResourceInterceptor{
+				tags:     &types.ServicePackageResourceTags{},
+				typeName: "aws_test",
+			}
+			r := &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					names.AttrTags:    tftags.TagsSchema(),
+					names.AttrTagsAll: tftags.TagsSchemaComputed(),
+				},
+				CustomizeDiff: interceptor.customizeDiff,
+			}
+			raw := map[string]interface{}{}
+			if testCase.tags != nil {
+				raw[names.AttrTags] = testCase.tags
+			}
+			config := terraform.NewResourceConfigRaw(raw)
+
+			_, err := r.Diff(ctx, nil, config, meta)
+
+			if testCase.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+			} else if err == nil {
+				t.Errorf("expected error %q", testCase.wantErr)
+			} else if got := err.Error(); got != testCase.wantErr {
+				t.Errorf("error = %q, want %q", got, testCase.wantErr)
+			}
+		})
+	}
+}
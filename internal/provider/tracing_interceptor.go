@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// tracingInterceptor records each CRUD operation as a span when tracing is configured.
+// AWS API calls made by the operation using AWS SDK for Go v2 clients are recorded as child spans.
+type tracingInterceptor struct {
+	typeName string
+}
+
+func (r tracingInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	c, ok := meta.(*conns.AWSClient)
+	if !ok {
+		return ctx, diags
+	}
+
+	switch when {
+	case Before:
+		ctx = c.StartOperationSpan(ctx, r.typeName, operationName(why))
+	case Finally:
+		c.EndOperationSpan(ctx, sdkdiag.DiagnosticsError(diags))
+	}
+
+	return ctx, diags
+}
+
+// operationName returns the name of a single CRUD operation.
+func operationName(why why) string {
+	switch why {
+	case Create:
+		return "Create"
+	case Read:
+		return "Read"
+	case Update:
+		return "Update"
+	case Delete:
+		return "Delete"
+	default:
+		return ""
+	}
+}
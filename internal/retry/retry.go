@@ -8,6 +8,8 @@ import (
 	"math"
 	"math/rand"
 	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/retrypolicy"
 )
 
 // Inspired by "github.com/ServiceWeaver/weaver/runtime/retry".
@@ -44,9 +46,12 @@ func Begin() *Retry {
 // It stops its sleep early and returns false if context becomes done.
 // If the return value is false, ctx.Err() is guaranteed to be non-nil.
 // The first call does not sleep.
+// Any retry policy in ctx overrides the minimum backoff duration and adds jitter.
 func (r *Retry) Continue(ctx context.Context) bool {
 	if r.attempt != 0 {
-		randomizedSleep(ctx, r.backoffDelay())
+		policy, _ := retrypolicy.RetryPolicyFromContext(ctx)
+		randomizedSleep(ctx, r.backoffDelay(policy))
+		sleep(ctx, policy.JitterDelay())
 	}
 	r.attempt++
 	return ctx.Err() == nil
@@ -58,9 +63,13 @@ func (r *Retry) Reset() {
 	r.attempt = 0
 }
 
-func (r *Retry) backoffDelay() time.Duration {
+func (r *Retry) backoffDelay(policy retrypolicy.RetryPolicy) time.Duration {
+	minDuration := r.options.BackoffMinDuration
+	if policy.BaseDelay > 0 {
+		minDuration = policy.BaseDelay
+	}
 	mult := math.Pow(r.options.BackoffMultiplier, float64(r.attempt))
-	return time.Duration(float64(r.options.BackoffMinDuration) * mult)
+	return time.Duration(float64(minDuration) * mult)
 }
 
 // Do not use the default RNG since we do not want different provider instances
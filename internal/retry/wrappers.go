@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/terraform-provider-aws/internal/retrypolicy"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -119,8 +120,10 @@ func (o operation[T]) UntilNotFound() operation[T] {
 }
 
 // Run retries an operation until the timeout elapses or predicate indicates otherwise.
+// Any retry policy in ctx overrides the timeout.
 func (o operation[T]) Run(ctx context.Context, timeout time.Duration) (T, error) {
-	ctx, cancel := context.WithTimeout(ctx, timeout)
+	policy, _ := retrypolicy.RetryPolicyFromContext(ctx)
+	ctx, cancel := context.WithTimeout(ctx, policy.Timeout(timeout))
 	defer cancel()
 
 	for r := Begin(); r.Continue(ctx); {
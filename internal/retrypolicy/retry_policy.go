@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package retrypolicy
+
+import (
+	"context"
+	"math/rand"
+	"time"
+)
+
+// RetryPolicy is a practitioner configured override of the retry behavior of a single resource.
+// Zero values leave the corresponding behavior unchanged.
+type RetryPolicy struct {
+	BaseDelay  time.Duration // Smallest time to wait between attempts
+	Jitter     time.Duration // Maximum random time added to each wait between attempts
+	MaxElapsed time.Duration // Time to keep retrying, overriding the operation's timeout
+}
+
+type retryPolicyKey struct{}
+
+// WithRetryPolicy returns a copy of ctx carrying the specified retry policy.
+// Retry helpers called with the returned Context honor the policy.
+func WithRetryPolicy(ctx context.Context, policy RetryPolicy) context.Context {
+	return context.WithValue(ctx, retryPolicyKey{}, policy)
+}
+
+// RetryPolicyFromContext returns any retry policy carried by ctx.
+func RetryPolicyFromContext(ctx context.Context) (RetryPolicy, bool) {
+	policy, ok := ctx.Value(retryPolicyKey{}).(RetryPolicy)
+	return policy, ok
+}
+
+// Timeout returns the time to keep retrying an operation with the specified timeout.
+func (p RetryPolicy) Timeout(timeout time.Duration) time.Duration {
+	if p.MaxElapsed > 0 {
+		return p.MaxElapsed
+	}
+
+	return timeout
+}
+
+// JitterDelay returns a random time between zero and the policy's jitter.
+func (p RetryPolicy) JitterDelay() time.Duration {
+	if p.Jitter <= 0 {
+		return 0
+	}
+
+	return time.Duration(rand.Int63n(int64(p.Jitter)))
+}
@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package retrypolicy
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestRetryPolicyFromContext(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	if _, ok := RetryPolicyFromContext(ctx); ok {
+		t.Fatal("expected no retry policy")
+	}
+
+	want := RetryPolicy{
+		BaseDelay:  1 * time.Second,
+		Jitter:     2 * time.Second,
+		MaxElapsed: 3 * time.Second,
+	}
+	got, ok := RetryPolicyFromContext(WithRetryPolicy(ctx, want))
+
+	if !ok {
+		t.Fatal("expected retry policy")
+	}
+	if got != want {
+		t.Errorf("RetryPolicyFromContext() = %v, want %v", got, want)
+	}
+}
+
+func TestRetryPolicyTimeout(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		policy   RetryPolicy
+		timeout  time.Duration
+		expected time.Duration
+	}{
+		"zero value": {
+			timeout:  1 * time.Minute,
+			expected: 1 * time.Minute,
+		},
+		"max elapsed": {
+			policy:   RetryPolicy{MaxElapsed: 10 * time.Minute},
+			timeout:  1 * time.Minute,
+			expected: 10 * time.Minute,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := testCase.policy.Timeout(testCase.timeout), testCase.expected; got != want {
+				t.Errorf("Timeout() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestRetryPolicyJitterDelay(t *testing.T) {
+	t.Parallel()
+
+	if got := (RetryPolicy{}).JitterDelay(); got != 0 {
+		t.Errorf("JitterDelay() = %v, want 0", got)
+	}
+
+	policy := RetryPolicy{Jitter: 10 * time.Millisecond}
+	for range 100 {
+		if got := policy.JitterDelay(); got < 0 || got >= policy.Jitter {
+			t.Fatalf("JitterDelay() = %v, want [0, %v)", got, policy.Jitter)
+		}
+	}
+}
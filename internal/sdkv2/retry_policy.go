@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/retrypolicy"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	retryPolicyAttributeName = "retry_policy"
+)
+
+// RetryPolicySchema returns the schema for the special `retry_policy` nested block.
+// A resource opts in to a practitioner configurable retry policy by adding the block to its schema.
+func RetryPolicySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"base_delay": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: verify.ValidDuration,
+				},
+				"jitter": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: verify.ValidDuration,
+				},
+				"max_elapsed": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: verify.ValidDuration,
+				},
+			},
+		},
+	}
+}
+
+// HasRetryPolicySchema returns whether the specified resource schema contains the `retry_policy` nested block.
+// Resources that define an unrelated `retry_policy` attribute are not matched.
+func HasRetryPolicySchema(s map[string]*schema.Schema) bool {
+	v, ok := s[retryPolicyAttributeName]
+	if !ok {
+		return false
+	}
+
+	elem, ok := v.Elem.(*schema.Resource)
+	if !ok {
+		return false
+	}
+
+	return slices.Equal(slices.Sorted(maps.Keys(elem.Schema)), slices.Sorted(maps.Keys(RetryPolicySchema().Elem.(*schema.Resource).Schema)))
+}
+
+// RetryPolicyFromResourceData returns any configured retry policy.
+func RetryPolicyFromResourceData(d interface{ Get(string) any }) (retrypolicy.RetryPolicy, bool) {
+	v, ok := d.Get(retryPolicyAttributeName).([]any)
+	if !ok || len(v) == 0 || v[0] == nil {
+		return retrypolicy.RetryPolicy{}, false
+	}
+
+	tfMap := v[0].(map[string]any)
+	duration := func(k string) time.Duration {
+		v, _ := time.ParseDuration(tfMap[k].(string))
+		return v
+	}
+
+	return retrypolicy.RetryPolicy{
+		BaseDelay:  duration("base_delay"),
+		Jitter:     duration("jitter"),
+		MaxElapsed: duration("max_elapsed"),
+	}, true
+}
@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/retrypolicy"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestHasRetryPolicySchema(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema   map[string]*schema.Schema
+		expected bool
+	}{
+		"no retry_policy": {
+			schema: map[string]*schema.Schema{
+				names.AttrName: {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+		},
+		"retry_policy": {
+			schema: map[string]*schema.Schema{
+				"retry_policy": RetryPolicySchema(),
+			},
+			expected: true,
+		},
+		"unrelated retry_policy": {
+			schema: map[string]*schema.Schema{
+				"retry_policy": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"maximum_retry_attempts": {
+								Type:     schema.TypeInt,
+								Optional: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := HasRetryPolicySchema(testCase.schema), testCase.expected; got != want {
+				t.Errorf("HasRetryPolicySchema = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestRetryPolicyFromResourceData(t *testing.T) {
+	t.Parallel()
+
+	s := map[string]*schema.Schema{
+		"retry_policy": RetryPolicySchema(),
+	}
+
+	testCases := map[string]struct {
+		raw        map[string]any
+		expected   retrypolicy.RetryPolicy
+		expectedOK bool
+	}{
+		"not configured": {
+			raw: map[string]any{},
+		},
+		"configured": {
+			raw: map[string]any{
+				"retry_policy": []any{
+					map[string]any{
+						"base_delay":  "2s",
+						"jitter":      "500ms",
+						"max_elapsed": "30m",
+					},
+				},
+			},
+			expected: retrypolicy.RetryPolicy{
+				BaseDelay:  2 * time.Second,
+				Jitter:     500 * time.Millisecond,
+				MaxElapsed: 30 * time.Minute,
+			},
+			expectedOK: true,
+		},
+		"partially configured": {
+			raw: map[string]any{
+				"retry_policy": []any{
+					map[string]any{
+						"max_elapsed": "1h",
+					},
+				},
+			},
+			expected: retrypolicy.RetryPolicy{
+				MaxElapsed: time.Hour,
+			},
+			expectedOK: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			d := schema.TestResourceDataRaw(t, s, testCase.raw)
+
+			got, ok := RetryPolicyFromResourceData(d)
+			if ok != testCase.expectedOK {
+				t.Errorf("ok = %t, want %t", ok, testCase.expectedOK)
+			}
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+type rawConfigGetter interface {
+	GetRawConfigAt(cty.Path) (cty.Value, diag.Diagnostics)
+}
+
+// GetWriteOnlyStringValue returns the value of the write-only string attribute at the specified path.
+// Write-only attribute values are never persisted in plan or state so are read from the raw configuration.
+// An empty string is returned if the attribute is not configured.
+func GetWriteOnlyStringValue(d rawConfigGetter, path cty.Path) (string, diag.Diagnostics) {
+	v, diags := d.GetRawConfigAt(path)
+	if diags.HasError() {
+		return "", diags
+	}
+
+	if !v.Type().Equals(cty.String) {
+		return "", diag.Errorf("write-only attribute %s is not a string", path)
+	}
+
+	if v.IsNull() || !v.IsKnown() {
+		return "", diags
+	}
+
+	return v.AsString(), diags
+}
@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+type mockRawConfigGetter struct {
+	config cty.Value
+}
+
+func (m mockRawConfigGetter) GetRawConfigAt(path cty.Path) (cty.Value, diag.Diagnostics) {
+	v, err := path.Apply(m.config)
+	if err != nil {
+		return cty.DynamicVal, diag.FromErr(err)
+	}
+
+	return v, nil
+}
+
+func TestGetWriteOnlyStringValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		config   cty.Value
+		path     cty.Path
+		expected string
+		wantErr  bool
+	}{
+		"value": {
+			config: cty.ObjectVal(map[string]cty.Value{
+				"password_wo": cty.StringVal("s3cr3t"),
+			}),
+			path:     cty.GetAttrPath("password_wo"),
+			expected: "s3cr3t",
+		},
+		"null": {
+			config: cty.ObjectVal(map[string]cty.Value{
+				"password_wo": cty.NullVal(cty.String),
+			}),
+			path: cty.GetAttrPath("password_wo"),
+		},
+		"unknown": {
+			config: cty.ObjectVal(map[string]cty.Value{
+				"password_wo": cty.UnknownVal(cty.String),
+			}),
+			path: cty.GetAttrPath("password_wo"),
+		},
+		"not a string": {
+			config: cty.ObjectVal(map[string]cty.Value{
+				"password_wo": cty.NumberIntVal(1),
+			}),
+			path:    cty.GetAttrPath("password_wo"),
+			wantErr: true,
+		},
+		"missing attribute": {
+			config: cty.ObjectVal(map[string]cty.Value{
+				"password": cty.StringVal("s3cr3t"),
+			}),
+			path:    cty.GetAttrPath("password_wo"),
+			wantErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := GetWriteOnlyStringValue(mockRawConfigGetter{config: testCase.config}, testCase.path)
+
+			if got, want := diags.HasError(), testCase.wantErr; got != want {
+				t.Fatalf("GetWriteOnlyStringValue error = %v, want error %t", diags, want)
+			}
+			if got, want := got, testCase.expected; got != want {
+				t.Errorf("GetWriteOnlyStringValue = %q, want %q", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acm
+
+import (
+	"context"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/acm"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/acm/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// @FrameworkDataSource("aws_acm_certificates", name="Certificates")
+func newCertificatesDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &certificatesDataSource{}, nil
+}
+
+type certificatesDataSource struct {
+	framework.PluralDataSource[certificateSummaryModel]
+}
+
+func (d *certificatesDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_acm_certificates"
+}
+
+func (d *certificatesDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	s, diags := d.PluralSchema(ctx, map[string]schema.Attribute{
+		"key_types": schema.SetAttribute{
+			CustomType:  fwtypes.SetOfStringType,
+			ElementType: types.StringType,
+			Optional:    true,
+			Validators: []validator.Set{
+				setvalidator.ValueStringsAre(enum.FrameworkValidate[awstypes.KeyAlgorithm]()),
+			},
+		},
+		"statuses": schema.SetAttribute{
+			CustomType:  fwtypes.SetOfStringType,
+			ElementType: types.StringType,
+			Optional:    true,
+			Validators: []validator.Set{
+				setvalidator.ValueStringsAre(enum.FrameworkValidate[awstypes.CertificateStatus]()),
+			},
+		},
+		"types": schema.SetAttribute{
+			CustomType:  fwtypes.SetOfStringType,
+			ElementType: types.StringType,
+			Optional:    true,
+			Validators: []validator.Set{
+				setvalidator.ValueStringsAre(enum.FrameworkValidate[awstypes.CertificateType]()),
+			},
+		},
+	})
+	response.Diagnostics.Append(diags...)
+	response.Schema = s
+}
+
+func (d *certificatesDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data certificatesDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().ACMClient(ctx)
+
+	input := acm.ListCertificatesInput{
+		CertificateStatuses: fwflex.ExpandFrameworkStringyValueSet[awstypes.CertificateStatus](ctx, data.Statuses),
+	}
+	if keyTypes := fwflex.ExpandFrameworkStringyValueSet[awstypes.KeyAlgorithm](ctx, data.KeyTypes); len(keyTypes) > 0 {
+		input.Includes = &awstypes.Filters{
+			KeyTypes: keyTypes,
+		}
+	}
+	certificateTypes := fwflex.ExpandFrameworkStringyValueSet[awstypes.CertificateType](ctx, data.Types)
+
+	response.Diagnostics.Append(d.ReadObjects(ctx, &data.PluralDataSourceModel, "ACM Certificates", func(ctx context.Context, fn func(framework.PluralDataSourceObject) bool) error {
+		return listCertificatesPages(ctx, conn, &input, func(page *acm.ListCertificatesOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, v := range page.CertificateSummaryList {
+				if len(certificateTypes) > 0 && !slices.Contains(certificateTypes, v.Type) {
+					continue
+				}
+
+				arn := aws.ToString(v.CertificateArn)
+
+				if !fn(framework.PluralDataSourceObject{
+					ARN: arn,
+					ID:  arn,
+					// Certificates are named by their domain names.
+					Names:  append([]string{aws.ToString(v.DomainName)}, v.SubjectAlternativeNameSummaries...),
+					Source: v,
+					// ListCertificates does not return certificate tags.
+					Tags: func(ctx context.Context) (tftags.KeyValueTags, error) {
+						return listTags(ctx, conn, arn)
+					},
+				}) {
+					return false
+				}
+			}
+
+			return !lastPage
+		})
+	})...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type certificatesDataSourceModel struct {
+	framework.PluralDataSourceModel[certificateSummaryModel]
+	KeyTypes fwtypes.SetValueOf[types.String] `tfsdk:"key_types"`
+	Statuses fwtypes.SetValueOf[types.String] `tfsdk:"statuses"`
+	Types    fwtypes.SetValueOf[types.String] `tfsdk:"types"`
+}
+
+type certificateSummaryModel struct {
+	CertificateARN                  types.String                      `tfsdk:"certificate_arn"`
+	CreatedAt                       timetypes.RFC3339                 `tfsdk:"created_at"`
+	DomainName                      types.String                      `tfsdk:"domain_name"`
+	InUse                           types.Bool                        `tfsdk:"in_use"`
+	IssuedAt                        timetypes.RFC3339                 `tfsdk:"issued_at"`
+	KeyAlgorithm                    types.String                      `tfsdk:"key_algorithm"`
+	NotAfter                        timetypes.RFC3339                 `tfsdk:"not_after"`
+	NotBefore                       timetypes.RFC3339                 `tfsdk:"not_before"`
+	RenewalEligibility              types.String                      `tfsdk:"renewal_eligibility"`
+	Status                          types.String                      `tfsdk:"status"`
+	SubjectAlternativeNameSummaries fwtypes.ListValueOf[types.String] `tfsdk:"subject_alternative_name_summaries"`
+	Type                            types.String                      `tfsdk:"type"`
+}
@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acm_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccACMCertificatesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_acm_certificate.test"
+	dataSourceName := "data.aws_acm_certificates.test"
+	key := acctest.TLSRSAPrivateKeyPEM(t, 4096)
+	certificate := acctest.TLSRSAX509SelfSignedCertificatePEM(t, key, acctest.RandomDomain().String())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ACMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCertificatesDataSourceConfig_basic(rName, acctest.TLSPEMEscapeNewlines(certificate), acctest.TLSPEMEscapeNewlines(key)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "arns.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arns.0", resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "summaries.0.domain_name", resourceName, names.AttrDomainName),
+					resource.TestCheckResourceAttr(dataSourceName, "summaries.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "summaries.0.key_algorithm", "RSA-4096"),
+					resource.TestCheckResourceAttr(dataSourceName, "summaries.0.type", "IMPORTED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCertificatesDataSourceConfig_basic(rName, certificate, key string) string {
+	return fmt.Sprintf(`
+resource "aws_acm_certificate" "test" {
+  certificate_body = "%[2]s"
+  private_key      = "%[3]s"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_acm_certificates" "test" {
+  key_types = ["RSA_4096"]
+  types     = ["IMPORTED"]
+
+  tags = {
+    Name = %[1]q
+  }
+
+  depends_on = [aws_acm_certificate.test]
+}
+`, rName, certificate, key)
+}
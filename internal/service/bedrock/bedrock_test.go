@@ -27,6 +27,7 @@ func TestAccBedrock_serial(t *testing.T) {
 		"ModelInvocationLoggingConfiguration": {
 			acctest.CtBasic:      testAccModelInvocationLoggingConfiguration_basic,
 			acctest.CtDisappears: testAccModelInvocationLoggingConfiguration_disappears,
+			"retryPolicy":        testAccModelInvocationLoggingConfiguration_retryPolicy,
 		},
 	}
 
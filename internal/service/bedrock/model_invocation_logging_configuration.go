@@ -101,6 +101,7 @@ func (r *resourceModelInvocationLoggingConfiguration) Schema(ctx context.Context
 					},
 				},
 			},
+			"retry_policy": framework.RetryPolicyBlock(ctx),
 		},
 	}
 }
@@ -118,7 +119,7 @@ func (r *resourceModelInvocationLoggingConfiguration) Create(ctx context.Context
 	}
 
 	// Set values for unknowns.
-	data.ID = types.StringValue(r.Meta().Region)
+	data.ID = types.StringValue(r.Meta().Region(ctx))
 
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
@@ -234,8 +235,9 @@ func findModelInvocationLoggingConfiguration(ctx context.Context, conn *bedrock.
 }
 
 type modelInvocationLoggingConfigurationResourceModel struct {
-	ID            types.String                              `tfsdk:"id"`
-	LoggingConfig fwtypes.ObjectValueOf[loggingConfigModel] `tfsdk:"logging_config"`
+	ID            types.String                                                `tfsdk:"id"`
+	LoggingConfig fwtypes.ObjectValueOf[loggingConfigModel]                   `tfsdk:"logging_config"`
+	RetryPolicy   fwtypes.ListNestedObjectValueOf[framework.RetryPolicyModel] `tfsdk:"retry_policy"`
 }
 
 type loggingConfigModel struct {
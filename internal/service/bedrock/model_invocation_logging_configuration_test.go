@@ -78,6 +78,46 @@ func testAccModelInvocationLoggingConfiguration_disappears(t *testing.T) {
 	})
 }
 
+func testAccModelInvocationLoggingConfiguration_retryPolicy(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrock_model_invocation_logging_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.BedrockEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckModelInvocationLoggingConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				// The IAM role is created in the same apply, so creation relies on the retry policy to wait for it to propagate.
+				Config: testAccModelInvocationLoggingConfigurationConfig_retryPolicy(rName, "5m"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckModelInvocationLoggingConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "retry_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "retry_policy.0.base_delay", "2s"),
+					resource.TestCheckResourceAttr(resourceName, "retry_policy.0.jitter", "1s"),
+					resource.TestCheckResourceAttr(resourceName, "retry_policy.0.max_elapsed", "5m"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"retry_policy"},
+			},
+			{
+				Config: testAccModelInvocationLoggingConfigurationConfig_retryPolicy(rName, "10m"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckModelInvocationLoggingConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "retry_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "retry_policy.0.max_elapsed", "10m"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckModelInvocationLoggingConfigurationExists(ctx context.Context, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		_, ok := s.RootModule().Resources[n]
@@ -118,7 +158,7 @@ func testAccCheckModelInvocationLoggingConfigurationDestroy(ctx context.Context)
 	}
 }
 
-func testAccModelInvocationLoggingConfigurationConfig_basic(rName string) string {
+func testAccModelInvocationLoggingConfigurationConfig_base(rName string) string {
 	return fmt.Sprintf(`
 data "aws_caller_identity" "current" {}
 data "aws_region" "current" {}
@@ -214,7 +254,11 @@ resource "aws_iam_role_policy_attachment" "test" {
   role       = aws_iam_role.test.name
   policy_arn = aws_iam_policy.test.arn
 }
+`, rName)
+}
 
+func testAccModelInvocationLoggingConfigurationConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccModelInvocationLoggingConfigurationConfig_base(rName), `
 resource "aws_bedrock_model_invocation_logging_configuration" "test" {
   depends_on = [
     aws_s3_bucket_policy.test,
@@ -237,5 +281,38 @@ resource "aws_bedrock_model_invocation_logging_configuration" "test" {
     }
   }
 }
-`, rName)
+`)
+}
+
+func testAccModelInvocationLoggingConfigurationConfig_retryPolicy(rName, maxElapsed string) string {
+	return acctest.ConfigCompose(testAccModelInvocationLoggingConfigurationConfig_base(rName), fmt.Sprintf(`
+resource "aws_bedrock_model_invocation_logging_configuration" "test" {
+  depends_on = [
+    aws_s3_bucket_policy.test,
+    aws_iam_role_policy_attachment.test,
+  ]
+
+  logging_config {
+    embedding_data_delivery_enabled = true
+    image_data_delivery_enabled     = true
+    text_data_delivery_enabled      = true
+
+    cloudwatch_config {
+      log_group_name = aws_cloudwatch_log_group.test.name
+      role_arn       = aws_iam_role.test.arn
+    }
+
+    s3_config {
+      bucket_name = aws_s3_bucket.test.id
+      key_prefix  = "bedrock"
+    }
+  }
+
+  retry_policy {
+    base_delay  = "2s"
+    jitter      = "1s"
+    max_elapsed = %[1]q
+  }
+}
+`, maxElapsed))
 }
@@ -147,6 +147,22 @@ func (r *agentResource) Schema(ctx context.Context, request resource.SchemaReque
 			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
 		},
 		Blocks: map[string]schema.Block{
+			"guardrail_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[guardrailConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"guardrail_identifier": schema.StringAttribute{
+							Required: true,
+						},
+						"guardrail_version": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
 			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
 				Create: true,
 				Update: true,
@@ -270,6 +286,7 @@ func (r *agentResource) Update(ctx context.Context, request resource.UpdateReque
 		!new.Description.Equal(old.Description) ||
 		!new.Instruction.Equal(old.Instruction) ||
 		!new.FoundationModel.Equal(old.FoundationModel) ||
+		!new.GuardrailConfiguration.Equal(old.GuardrailConfiguration) ||
 		!new.PromptOverrideConfiguration.Equal(old.PromptOverrideConfiguration) {
 		input := &bedrockagent.UpdateAgentInput{
 			AgentId:                 fwflex.StringFromFramework(ctx, new.AgentID),
@@ -285,6 +302,17 @@ func (r *agentResource) Update(ctx context.Context, request resource.UpdateReque
 			input.CustomerEncryptionKeyArn = fwflex.StringFromFramework(ctx, new.CustomerEncryptionKeyARN)
 		}
 
+		// The guardrail configuration is replaced on every update, so always send it.
+		if !new.GuardrailConfiguration.IsNull() {
+			guardrailConfiguration := &awstypes.GuardrailConfiguration{}
+			response.Diagnostics.Append(fwflex.Expand(ctx, new.GuardrailConfiguration, guardrailConfiguration)...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+
+			input.GuardrailConfiguration = guardrailConfiguration
+		}
+
 		if !new.PromptOverrideConfiguration.Equal(old.PromptOverrideConfiguration) {
 			promptOverrideConfiguration := &awstypes.PromptOverrideConfiguration{}
 			response.Diagnostics.Append(fwflex.Expand(ctx, new.PromptOverrideConfiguration, promptOverrideConfiguration)...)
@@ -539,6 +567,7 @@ type agentResourceModel struct {
 	CustomerEncryptionKeyARN    fwtypes.ARN                                                       `tfsdk:"customer_encryption_key_arn"`
 	Description                 types.String                                                      `tfsdk:"description"`
 	FoundationModel             types.String                                                      `tfsdk:"foundation_model"`
+	GuardrailConfiguration      fwtypes.ListNestedObjectValueOf[guardrailConfigurationModel]      `tfsdk:"guardrail_configuration"`
 	ID                          types.String                                                      `tfsdk:"id"`
 	IdleSessionTTLInSeconds     types.Int64                                                       `tfsdk:"idle_session_ttl_in_seconds"`
 	Instruction                 types.String                                                      `tfsdk:"instruction"`
@@ -559,6 +588,11 @@ func (m *agentResourceModel) setID() {
 	m.ID = m.AgentID
 }
 
+type guardrailConfigurationModel struct {
+	GuardrailIdentifier types.String `tfsdk:"guardrail_identifier"`
+	GuardrailVersion    types.String `tfsdk:"guardrail_version"`
+}
+
 type promptOverrideConfigurationModel struct {
 	OverrideLambda       fwtypes.ARN                                              `tfsdk:"override_lambda"`
 	PromptConfigurations fwtypes.SetNestedObjectValueOf[promptConfigurationModel] `tfsdk:"prompt_configurations"`
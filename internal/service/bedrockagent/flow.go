@@ -0,0 +1,1667 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrockagent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagent"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/bedrockagent/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Flow")
+// @Tags(identifierAttribute="arn")
+func newFlowResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &flowResource{}
+
+	r.SetDefaultCreateTimeout(5 * time.Minute)
+	r.SetDefaultUpdateTimeout(5 * time.Minute)
+
+	return r, nil
+}
+
+type flowResource struct {
+	framework.ResourceWithConfigure
+	framework.WithTimeouts
+}
+
+func (*flowResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_bedrockagent_flow"
+}
+
+func (r *flowResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	nameValidator := stringvalidator.RegexMatches(regexache.MustCompile(`^[a-zA-Z]([_]?[0-9a-zA-Z]){0,49}$`), "must start with a letter and contain only alphanumeric characters and underscores (up to 50)")
+	s3ServiceConfigurationBlock := schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[flowNodeServiceConfigurationModel](ctx),
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtLeast(1),
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				"s3": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[flowNodeS3ConfigurationModel](ctx),
+					Validators: []validator.List{
+						listvalidator.IsRequired(),
+						listvalidator.SizeAtLeast(1),
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							names.AttrBucketName: schema.StringAttribute{
+								Required: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrCreatedAt: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"customer_encryption_key_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 200),
+				},
+			},
+			names.AttrExecutionRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexache.MustCompile(`^([0-9a-zA-Z][_-]?){1,100}$`), "valid characters are a-z, A-Z, 0-9, _ (underscore) and - (hyphen). The name can have up to 100 characters"),
+				},
+			},
+			"prepare_flow": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.FlowStatus](),
+				Computed:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"updated_at": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			names.AttrVersion: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"definition": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[flowDefinitionModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"connection": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[flowConnectionModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrName: schema.StringAttribute{
+										Required: true,
+										Validators: []validator.String{
+											stringvalidator.RegexMatches(regexache.MustCompile(`^[a-zA-Z]([_]?[0-9a-zA-Z]){1,100}$`), "must start with a letter and contain only alphanumeric characters and underscores (up to 100)"),
+										},
+									},
+									names.AttrSource: schema.StringAttribute{
+										Required:   true,
+										Validators: []validator.String{nameValidator},
+									},
+									names.AttrTarget: schema.StringAttribute{
+										Required:   true,
+										Validators: []validator.String{nameValidator},
+									},
+									names.AttrType: schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.FlowConnectionType](),
+										Required:   true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									names.AttrConfiguration: schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[flowConnectionConfigurationModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Blocks: map[string]schema.Block{
+												"conditional": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[flowConditionalConnectionConfigurationModel](ctx),
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(1),
+														listvalidator.ExactlyOneOf(
+															path.MatchRelative().AtParent().AtName("conditional"),
+															path.MatchRelative().AtParent().AtName("data"),
+														),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Attributes: map[string]schema.Attribute{
+															names.AttrCondition: schema.StringAttribute{
+																Required:   true,
+																Validators: []validator.String{nameValidator},
+															},
+														},
+													},
+												},
+												"data": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[flowDataConnectionConfigurationModel](ctx),
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(1),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Attributes: map[string]schema.Attribute{
+															"source_output": schema.StringAttribute{
+																Required:   true,
+																Validators: []validator.String{nameValidator},
+															},
+															"target_input": schema.StringAttribute{
+																Required:   true,
+																Validators: []validator.String{nameValidator},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"node": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[flowNodeModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrName: schema.StringAttribute{
+										Required:   true,
+										Validators: []validator.String{nameValidator},
+									},
+									names.AttrType: schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.FlowNodeType](),
+										Required:   true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									names.AttrConfiguration: schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[flowNodeConfigurationModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Blocks: map[string]schema.Block{
+												"agent": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[agentFlowNodeConfigurationModel](ctx),
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(1),
+														listvalidator.ExactlyOneOf(
+															path.MatchRelative().AtParent().AtName("agent"),
+															path.MatchRelative().AtParent().AtName(names.AttrCondition),
+															path.MatchRelative().AtParent().AtName("knowledge_base"),
+															path.MatchRelative().AtParent().AtName("lambda_function"),
+															path.MatchRelative().AtParent().AtName("lex"),
+															path.MatchRelative().AtParent().AtName("prompt"),
+															path.MatchRelative().AtParent().AtName("retrieval"),
+															path.MatchRelative().AtParent().AtName("storage"),
+														),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Attributes: map[string]schema.Attribute{
+															"agent_alias_arn": schema.StringAttribute{
+																CustomType: fwtypes.ARNType,
+																Required:   true,
+															},
+														},
+													},
+												},
+												names.AttrCondition: schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[conditionFlowNodeConfigurationModel](ctx),
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(1),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Blocks: map[string]schema.Block{
+															names.AttrCondition: schema.ListNestedBlock{
+																CustomType: fwtypes.NewListNestedObjectTypeOf[flowConditionModel](ctx),
+																Validators: []validator.List{
+																	listvalidator.IsRequired(),
+																	listvalidator.SizeBetween(1, 5),
+																},
+																NestedObject: schema.NestedBlockObject{
+																	Attributes: map[string]schema.Attribute{
+																		names.AttrExpression: schema.StringAttribute{
+																			Optional: true,
+																			Validators: []validator.String{
+																				stringvalidator.LengthBetween(1, 64),
+																			},
+																		},
+																		names.AttrName: schema.StringAttribute{
+																			Required:   true,
+																			Validators: []validator.String{nameValidator},
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+												"knowledge_base": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[knowledgeBaseFlowNodeConfigurationModel](ctx),
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(1),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Attributes: map[string]schema.Attribute{
+															"knowledge_base_id": schema.StringAttribute{
+																Required: true,
+																Validators: []validator.String{
+																	stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9a-zA-Z]+$`), "must contain only alphanumeric characters"),
+																	stringvalidator.LengthAtMost(10),
+																},
+															},
+															"model_id": schema.StringAttribute{
+																Optional: true,
+															},
+														},
+													},
+												},
+												"lambda_function": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[lambdaFunctionFlowNodeConfigurationModel](ctx),
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(1),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Attributes: map[string]schema.Attribute{
+															"lambda_arn": schema.StringAttribute{
+																CustomType: fwtypes.ARNType,
+																Required:   true,
+															},
+														},
+													},
+												},
+												"lex": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[lexFlowNodeConfigurationModel](ctx),
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(1),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Attributes: map[string]schema.Attribute{
+															"bot_alias_arn": schema.StringAttribute{
+																CustomType: fwtypes.ARNType,
+																Required:   true,
+															},
+															"locale_id": schema.StringAttribute{
+																Required: true,
+																Validators: []validator.String{
+																	stringvalidator.LengthBetween(1, 10),
+																},
+															},
+														},
+													},
+												},
+												"prompt": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[promptFlowNodeConfigurationModel](ctx),
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(1),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Blocks: map[string]schema.Block{
+															"source_configuration": schema.ListNestedBlock{
+																CustomType: fwtypes.NewListNestedObjectTypeOf[promptFlowNodeSourceConfigurationModel](ctx),
+																Validators: []validator.List{
+																	listvalidator.IsRequired(),
+																	listvalidator.SizeAtLeast(1),
+																	listvalidator.SizeAtMost(1),
+																},
+																NestedObject: schema.NestedBlockObject{
+																	Blocks: map[string]schema.Block{
+																		"inline": schema.ListNestedBlock{
+																			CustomType: fwtypes.NewListNestedObjectTypeOf[promptFlowNodeInlineConfigurationModel](ctx),
+																			Validators: []validator.List{
+																				listvalidator.SizeAtMost(1),
+																				listvalidator.ExactlyOneOf(
+																					path.MatchRelative().AtParent().AtName("inline"),
+																					path.MatchRelative().AtParent().AtName("resource"),
+																				),
+																			},
+																			NestedObject: schema.NestedBlockObject{
+																				Attributes: map[string]schema.Attribute{
+																					"model_id": schema.StringAttribute{
+																						Required: true,
+																					},
+																					"template_type": schema.StringAttribute{
+																						CustomType: fwtypes.StringEnumType[awstypes.PromptTemplateType](),
+																						Required:   true,
+																					},
+																				},
+																				Blocks: map[string]schema.Block{
+																					"inference_configuration": promptInferenceConfigurationBlock(ctx),
+																					"template_configuration":  promptTemplateConfigurationBlock(ctx),
+																				},
+																			},
+																		},
+																		"resource": schema.ListNestedBlock{
+																			CustomType: fwtypes.NewListNestedObjectTypeOf[promptFlowNodeResourceConfigurationModel](ctx),
+																			Validators: []validator.List{
+																				listvalidator.SizeAtMost(1),
+																			},
+																			NestedObject: schema.NestedBlockObject{
+																				Attributes: map[string]schema.Attribute{
+																					"prompt_arn": schema.StringAttribute{
+																						CustomType: fwtypes.ARNType,
+																						Required:   true,
+																					},
+																				},
+																			},
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+												"retrieval": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[retrievalFlowNodeConfigurationModel](ctx),
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(1),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Blocks: map[string]schema.Block{
+															"service_configuration": s3ServiceConfigurationBlock,
+														},
+													},
+												},
+												"storage": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[storageFlowNodeConfigurationModel](ctx),
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(1),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Blocks: map[string]schema.Block{
+															"service_configuration": s3ServiceConfigurationBlock,
+														},
+													},
+												},
+											},
+										},
+									},
+									"input": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[flowNodeInputModel](ctx),
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												names.AttrExpression: schema.StringAttribute{
+													Required: true,
+													Validators: []validator.String{
+														stringvalidator.LengthBetween(1, 64),
+													},
+												},
+												names.AttrName: schema.StringAttribute{
+													Required:   true,
+													Validators: []validator.String{nameValidator},
+												},
+												names.AttrType: schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.FlowNodeIODataType](),
+													Required:   true,
+												},
+											},
+										},
+									},
+									"output": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[flowNodeOutputModel](ctx),
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												names.AttrName: schema.StringAttribute{
+													Required:   true,
+													Validators: []validator.String{nameValidator},
+												},
+												names.AttrType: schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.FlowNodeIODataType](),
+													Required:   true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
+		},
+	}
+}
+
+func (r *flowResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var data flowResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Definition.IsNull() || data.Definition.IsUnknown() {
+		return
+	}
+
+	definitionData, diags := data.Definition.ToPtr(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() || definitionData == nil {
+		return
+	}
+
+	// Connections can only be checked once every node is known.
+	if definitionData.Nodes.IsUnknown() || definitionData.Connections.IsNull() || definitionData.Connections.IsUnknown() {
+		return
+	}
+
+	nodesData, diags := definitionData.Nodes.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	type flowNodePorts struct {
+		inputs  map[string]struct{}
+		outputs map[string]struct{}
+	}
+	nodes := make(map[string]*flowNodePorts)
+
+	for _, nodeData := range nodesData {
+		if nodeData.Name.IsUnknown() {
+			return
+		}
+
+		ports := &flowNodePorts{}
+
+		if !nodeData.Inputs.IsUnknown() {
+			inputsData, diags := nodeData.Inputs.ToSlice(ctx)
+			response.Diagnostics.Append(diags...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+
+			ports.inputs = make(map[string]struct{})
+			for _, inputData := range inputsData {
+				if inputData.Name.IsUnknown() {
+					ports.inputs = nil
+					break
+				}
+				ports.inputs[inputData.Name.ValueString()] = struct{}{}
+			}
+		}
+
+		if !nodeData.Outputs.IsUnknown() {
+			outputsData, diags := nodeData.Outputs.ToSlice(ctx)
+			response.Diagnostics.Append(diags...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+
+			ports.outputs = make(map[string]struct{})
+			for _, outputData := range outputsData {
+				if outputData.Name.IsUnknown() {
+					ports.outputs = nil
+					break
+				}
+				ports.outputs[outputData.Name.ValueString()] = struct{}{}
+			}
+		}
+
+		nodes[nodeData.Name.ValueString()] = ports
+	}
+
+	connectionsData, diags := definitionData.Connections.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	for i, connectionData := range connectionsData {
+		connectionPath := path.Root("definition").AtListIndex(0).AtName("connection").AtListIndex(i)
+
+		var source, target *flowNodePorts
+
+		if !connectionData.Source.IsUnknown() {
+			var ok bool
+			if source, ok = nodes[connectionData.Source.ValueString()]; !ok {
+				response.Diagnostics.AddAttributeError(
+					connectionPath.AtName(names.AttrSource),
+					"Invalid Attribute Configuration",
+					fmt.Sprintf("connection %q source node %q is not defined", connectionData.Name.ValueString(), connectionData.Source.ValueString()),
+				)
+			}
+		}
+
+		if !connectionData.Target.IsUnknown() {
+			var ok bool
+			if target, ok = nodes[connectionData.Target.ValueString()]; !ok {
+				response.Diagnostics.AddAttributeError(
+					connectionPath.AtName(names.AttrTarget),
+					"Invalid Attribute Configuration",
+					fmt.Sprintf("connection %q target node %q is not defined", connectionData.Name.ValueString(), connectionData.Target.ValueString()),
+				)
+			}
+		}
+
+		if connectionData.Configuration.IsNull() || connectionData.Configuration.IsUnknown() {
+			continue
+		}
+
+		configurationData, diags := connectionData.Configuration.ToPtr(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if configurationData == nil || configurationData.Data.IsNull() || configurationData.Data.IsUnknown() {
+			continue
+		}
+
+		dataData, diags := configurationData.Data.ToPtr(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if dataData == nil {
+			continue
+		}
+
+		dataPath := connectionPath.AtName(names.AttrConfiguration).AtListIndex(0).AtName("data").AtListIndex(0)
+
+		if source != nil && source.outputs != nil && !dataData.SourceOutput.IsUnknown() {
+			if _, ok := source.outputs[dataData.SourceOutput.ValueString()]; !ok {
+				response.Diagnostics.AddAttributeError(
+					dataPath.AtName("source_output"),
+					"Invalid Attribute Configuration",
+					fmt.Sprintf("connection %q source node %q has no output named %q", connectionData.Name.ValueString(), connectionData.Source.ValueString(), dataData.SourceOutput.ValueString()),
+				)
+			}
+		}
+
+		if target != nil && target.inputs != nil && !dataData.TargetInput.IsUnknown() {
+			if _, ok := target.inputs[dataData.TargetInput.ValueString()]; !ok {
+				response.Diagnostics.AddAttributeError(
+					dataPath.AtName("target_input"),
+					"Invalid Attribute Configuration",
+					fmt.Sprintf("connection %q target node %q has no input named %q", connectionData.Name.ValueString(), connectionData.Target.ValueString(), dataData.TargetInput.ValueString()),
+				)
+			}
+		}
+	}
+}
+
+func (r *flowResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data flowResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	input := &bedrockagent.CreateFlowInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input, fwflex.WithIgnoredFieldNamesAppend("Definition"))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// AutoFlEx doesn't yet handle union types.
+	definition, diags := expandFlowDefinition(ctx, data.Definition)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(id.UniqueId())
+	input.Definition = definition
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateFlow(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Bedrock Agent Flow (%s)", data.Name.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = fwflex.StringToFramework(ctx, output.Arn)
+	data.CreatedAt = fwflex.TimeToFramework(ctx, output.CreatedAt)
+	data.ID = fwflex.StringToFramework(ctx, output.Id)
+	data.Status = fwtypes.StringEnumValue(output.Status)
+	data.UpdatedAt = fwflex.TimeToFramework(ctx, output.UpdatedAt)
+	data.Version = fwflex.StringToFramework(ctx, output.Version)
+
+	if data.PrepareFlow.ValueBool() {
+		flow, err := prepareFlow(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+		if err != nil {
+			response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+			response.Diagnostics.AddError(fmt.Sprintf("creating Bedrock Agent Flow (%s)", data.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		data.Status = fwtypes.StringEnumValue(flow.Status)
+		data.UpdatedAt = fwflex.TimeToFramework(ctx, flow.UpdatedAt)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *flowResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data flowResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	output, err := findFlowByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock Agent Flow (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data, fwflex.WithIgnoredFieldNamesAppend("Definition"))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// AutoFlEx doesn't yet handle union types.
+	definition, diags := flattenFlowDefinition(ctx, output.Definition)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.Definition = definition
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *flowResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new flowResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	if !new.CustomerEncryptionKeyARN.Equal(old.CustomerEncryptionKeyARN) ||
+		!new.Definition.Equal(old.Definition) ||
+		!new.Description.Equal(old.Description) ||
+		!new.ExecutionRoleARN.Equal(old.ExecutionRoleARN) ||
+		!new.Name.Equal(old.Name) {
+		input := &bedrockagent.UpdateFlowInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input, fwflex.WithIgnoredFieldNamesAppend("Definition"))...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// AutoFlEx doesn't yet handle union types.
+		definition, diags := expandFlowDefinition(ctx, new.Definition)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.Definition = definition
+		input.FlowIdentifier = fwflex.StringFromFramework(ctx, new.ID)
+
+		output, err := conn.UpdateFlow(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Bedrock Agent Flow (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		new.Status = fwtypes.StringEnumValue(output.Status)
+		new.UpdatedAt = fwflex.TimeToFramework(ctx, output.UpdatedAt)
+
+		if new.PrepareFlow.ValueBool() {
+			flow, err := prepareFlow(ctx, conn, new.ID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts))
+
+			if err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("updating Bedrock Agent Flow (%s)", new.ID.ValueString()), err.Error())
+
+				return
+			}
+
+			new.Status = fwtypes.StringEnumValue(flow.Status)
+			new.UpdatedAt = fwflex.TimeToFramework(ctx, flow.UpdatedAt)
+		}
+	} else {
+		new.Status = old.Status
+		new.UpdatedAt = old.UpdatedAt
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *flowResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data flowResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	_, err := conn.DeleteFlow(ctx, &bedrockagent.DeleteFlowInput{
+		FlowIdentifier: fwflex.StringFromFramework(ctx, data.ID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Bedrock Agent Flow (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *flowResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrID), request.ID)...)
+	// Set prepare_flow to default value on import.
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("prepare_flow"), true)...)
+}
+
+func (r *flowResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func prepareFlow(ctx context.Context, conn *bedrockagent.Client, id string, timeout time.Duration) (*bedrockagent.GetFlowOutput, error) {
+	input := &bedrockagent.PrepareFlowInput{
+		FlowIdentifier: aws.String(id),
+	}
+
+	_, err := conn.PrepareFlow(ctx, input)
+
+	if err != nil {
+		return nil, fmt.Errorf("preparing Bedrock Agent Flow (%s): %w", id, err)
+	}
+
+	output, err := waitFlowPrepared(ctx, conn, id, timeout)
+
+	if err != nil {
+		return nil, fmt.Errorf("waiting for Bedrock Agent Flow (%s) prepare: %w", id, err)
+	}
+
+	return output, nil
+}
+
+func findFlowByID(ctx context.Context, conn *bedrockagent.Client, id string) (*bedrockagent.GetFlowOutput, error) {
+	input := &bedrockagent.GetFlowInput{
+		FlowIdentifier: aws.String(id),
+	}
+
+	output, err := conn.GetFlow(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusFlow(ctx context.Context, conn *bedrockagent.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findFlowByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitFlowPrepared(ctx context.Context, conn *bedrockagent.Client, id string, timeout time.Duration) (*bedrockagent.GetFlowOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.FlowStatusNotPrepared, awstypes.FlowStatusPreparing),
+		Target:  enum.Slice(awstypes.FlowStatusPrepared),
+		Refresh: statusFlow(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*bedrockagent.GetFlowOutput); ok {
+		tfresource.SetLastError(err, errors.Join(tfslices.ApplyToAll(output.Validations, func(v awstypes.FlowValidation) error {
+			return errors.New(aws.ToString(v.Message))
+		})...))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+type flowResourceModel struct {
+	ARN                      types.String                                         `tfsdk:"arn"`
+	CreatedAt                timetypes.RFC3339                                    `tfsdk:"created_at"`
+	CustomerEncryptionKeyARN fwtypes.ARN                                          `tfsdk:"customer_encryption_key_arn"`
+	Definition               fwtypes.ListNestedObjectValueOf[flowDefinitionModel] `tfsdk:"definition"`
+	Description              types.String                                         `tfsdk:"description"`
+	ExecutionRoleARN         fwtypes.ARN                                          `tfsdk:"execution_role_arn"`
+	ID                       types.String                                         `tfsdk:"id"`
+	Name                     types.String                                         `tfsdk:"name"`
+	PrepareFlow              types.Bool                                           `tfsdk:"prepare_flow"`
+	Status                   fwtypes.StringEnum[awstypes.FlowStatus]              `tfsdk:"status"`
+	Tags                     types.Map                                            `tfsdk:"tags"`
+	TagsAll                  types.Map                                            `tfsdk:"tags_all"`
+	Timeouts                 timeouts.Value                                       `tfsdk:"timeouts"`
+	UpdatedAt                timetypes.RFC3339                                    `tfsdk:"updated_at"`
+	Version                  types.String                                         `tfsdk:"version"`
+}
+
+type flowDefinitionModel struct {
+	Connections fwtypes.ListNestedObjectValueOf[flowConnectionModel] `tfsdk:"connection"`
+	Nodes       fwtypes.ListNestedObjectValueOf[flowNodeModel]       `tfsdk:"node"`
+}
+
+type flowConnectionModel struct {
+	Configuration fwtypes.ListNestedObjectValueOf[flowConnectionConfigurationModel] `tfsdk:"configuration"`
+	Name          types.String                                                      `tfsdk:"name"`
+	Source        types.String                                                      `tfsdk:"source"`
+	Target        types.String                                                      `tfsdk:"target"`
+	Type          fwtypes.StringEnum[awstypes.FlowConnectionType]                   `tfsdk:"type"`
+}
+
+type flowConnectionConfigurationModel struct {
+	Conditional fwtypes.ListNestedObjectValueOf[flowConditionalConnectionConfigurationModel] `tfsdk:"conditional"`
+	Data        fwtypes.ListNestedObjectValueOf[flowDataConnectionConfigurationModel]        `tfsdk:"data"`
+}
+
+type flowConditionalConnectionConfigurationModel struct {
+	Condition types.String `tfsdk:"condition"`
+}
+
+type flowDataConnectionConfigurationModel struct {
+	SourceOutput types.String `tfsdk:"source_output"`
+	TargetInput  types.String `tfsdk:"target_input"`
+}
+
+type flowNodeModel struct {
+	Configuration fwtypes.ListNestedObjectValueOf[flowNodeConfigurationModel] `tfsdk:"configuration"`
+	Inputs        fwtypes.ListNestedObjectValueOf[flowNodeInputModel]         `tfsdk:"input"`
+	Name          types.String                                                `tfsdk:"name"`
+	Outputs       fwtypes.ListNestedObjectValueOf[flowNodeOutputModel]        `tfsdk:"output"`
+	Type          fwtypes.StringEnum[awstypes.FlowNodeType]                   `tfsdk:"type"`
+}
+
+type flowNodeInputModel struct {
+	Expression types.String                                    `tfsdk:"expression"`
+	Name       types.String                                    `tfsdk:"name"`
+	Type       fwtypes.StringEnum[awstypes.FlowNodeIODataType] `tfsdk:"type"`
+}
+
+type flowNodeOutputModel struct {
+	Name types.String                                    `tfsdk:"name"`
+	Type fwtypes.StringEnum[awstypes.FlowNodeIODataType] `tfsdk:"type"`
+}
+
+type flowNodeConfigurationModel struct {
+	Agent          fwtypes.ListNestedObjectValueOf[agentFlowNodeConfigurationModel]          `tfsdk:"agent"`
+	Condition      fwtypes.ListNestedObjectValueOf[conditionFlowNodeConfigurationModel]      `tfsdk:"condition"`
+	KnowledgeBase  fwtypes.ListNestedObjectValueOf[knowledgeBaseFlowNodeConfigurationModel]  `tfsdk:"knowledge_base"`
+	LambdaFunction fwtypes.ListNestedObjectValueOf[lambdaFunctionFlowNodeConfigurationModel] `tfsdk:"lambda_function"`
+	Lex            fwtypes.ListNestedObjectValueOf[lexFlowNodeConfigurationModel]            `tfsdk:"lex"`
+	Prompt         fwtypes.ListNestedObjectValueOf[promptFlowNodeConfigurationModel]         `tfsdk:"prompt"`
+	Retrieval      fwtypes.ListNestedObjectValueOf[retrievalFlowNodeConfigurationModel]      `tfsdk:"retrieval"`
+	Storage        fwtypes.ListNestedObjectValueOf[storageFlowNodeConfigurationModel]        `tfsdk:"storage"`
+}
+
+type agentFlowNodeConfigurationModel struct {
+	AgentAliasARN fwtypes.ARN `tfsdk:"agent_alias_arn"`
+}
+
+type conditionFlowNodeConfigurationModel struct {
+	Conditions fwtypes.ListNestedObjectValueOf[flowConditionModel] `tfsdk:"condition"`
+}
+
+type flowConditionModel struct {
+	Expression types.String `tfsdk:"expression"`
+	Name       types.String `tfsdk:"name"`
+}
+
+type knowledgeBaseFlowNodeConfigurationModel struct {
+	KnowledgeBaseID types.String `tfsdk:"knowledge_base_id"`
+	ModelID         types.String `tfsdk:"model_id"`
+}
+
+type lambdaFunctionFlowNodeConfigurationModel struct {
+	LambdaARN fwtypes.ARN `tfsdk:"lambda_arn"`
+}
+
+type lexFlowNodeConfigurationModel struct {
+	BotAliasARN fwtypes.ARN  `tfsdk:"bot_alias_arn"`
+	LocaleID    types.String `tfsdk:"locale_id"`
+}
+
+type promptFlowNodeConfigurationModel struct {
+	SourceConfiguration fwtypes.ListNestedObjectValueOf[promptFlowNodeSourceConfigurationModel] `tfsdk:"source_configuration"`
+}
+
+type promptFlowNodeSourceConfigurationModel struct {
+	Inline   fwtypes.ListNestedObjectValueOf[promptFlowNodeInlineConfigurationModel]   `tfsdk:"inline"`
+	Resource fwtypes.ListNestedObjectValueOf[promptFlowNodeResourceConfigurationModel] `tfsdk:"resource"`
+}
+
+type promptFlowNodeInlineConfigurationModel struct {
+	InferenceConfiguration fwtypes.ListNestedObjectValueOf[promptInferenceConfigurationModel] `tfsdk:"inference_configuration"`
+	ModelID                types.String                                                       `tfsdk:"model_id"`
+	TemplateConfiguration  fwtypes.ListNestedObjectValueOf[promptTemplateConfigurationModel]  `tfsdk:"template_configuration"`
+	TemplateType           fwtypes.StringEnum[awstypes.PromptTemplateType]                    `tfsdk:"template_type"`
+}
+
+type promptFlowNodeResourceConfigurationModel struct {
+	PromptARN fwtypes.ARN `tfsdk:"prompt_arn"`
+}
+
+type retrievalFlowNodeConfigurationModel struct {
+	ServiceConfiguration fwtypes.ListNestedObjectValueOf[flowNodeServiceConfigurationModel] `tfsdk:"service_configuration"`
+}
+
+type storageFlowNodeConfigurationModel struct {
+	ServiceConfiguration fwtypes.ListNestedObjectValueOf[flowNodeServiceConfigurationModel] `tfsdk:"service_configuration"`
+}
+
+type flowNodeServiceConfigurationModel struct {
+	S3 fwtypes.ListNestedObjectValueOf[flowNodeS3ConfigurationModel] `tfsdk:"s3"`
+}
+
+type flowNodeS3ConfigurationModel struct {
+	BucketName types.String `tfsdk:"bucket_name"`
+}
+
+func expandFlowDefinition(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[flowDefinitionModel]) (*awstypes.FlowDefinition, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	data, d := tfList.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || data == nil {
+		return nil, diags
+	}
+
+	apiObject := &awstypes.FlowDefinition{}
+
+	nodesData, d := data.Nodes.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	for _, nodeData := range nodesData {
+		var node awstypes.FlowNode
+		diags.Append(fwflex.Expand(ctx, nodeData, &node, fwflex.WithIgnoredFieldNamesAppend("Configuration"))...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		node.Configuration, d = expandFlowNodeConfiguration(ctx, nodeData.Type.ValueEnum(), nodeData.Configuration)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		apiObject.Nodes = append(apiObject.Nodes, node)
+	}
+
+	connectionsData, d := data.Connections.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	for _, connectionData := range connectionsData {
+		var connection awstypes.FlowConnection
+		diags.Append(fwflex.Expand(ctx, connectionData, &connection, fwflex.WithIgnoredFieldNamesAppend("Configuration"))...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		connection.Configuration, d = expandFlowConnectionConfiguration(ctx, connectionData.Configuration)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		apiObject.Connections = append(apiObject.Connections, connection)
+	}
+
+	return apiObject, diags
+}
+
+func expandFlowNodeConfiguration(ctx context.Context, nodeType awstypes.FlowNodeType, tfList fwtypes.ListNestedObjectValueOf[flowNodeConfigurationModel]) (awstypes.FlowNodeConfiguration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// These node types have no configuration of their own.
+	switch nodeType {
+	case awstypes.FlowNodeTypeCollector:
+		return &awstypes.FlowNodeConfigurationMemberCollector{}, diags
+	case awstypes.FlowNodeTypeInput:
+		return &awstypes.FlowNodeConfigurationMemberInput{}, diags
+	case awstypes.FlowNodeTypeIterator:
+		return &awstypes.FlowNodeConfigurationMemberIterator{}, diags
+	case awstypes.FlowNodeTypeOutput:
+		return &awstypes.FlowNodeConfigurationMemberOutput{}, diags
+	}
+
+	data, d := tfList.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || data == nil {
+		return nil, diags
+	}
+
+	switch {
+	case !data.Agent.IsNull():
+		agentData, d := data.Agent.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var apiObject awstypes.FlowNodeConfigurationMemberAgent
+		diags.Append(fwflex.Expand(ctx, agentData, &apiObject.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &apiObject, diags
+
+	case !data.Condition.IsNull():
+		conditionData, d := data.Condition.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var apiObject awstypes.FlowNodeConfigurationMemberCondition
+		diags.Append(fwflex.Expand(ctx, conditionData, &apiObject.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &apiObject, diags
+
+	case !data.KnowledgeBase.IsNull():
+		knowledgeBaseData, d := data.KnowledgeBase.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var apiObject awstypes.FlowNodeConfigurationMemberKnowledgeBase
+		diags.Append(fwflex.Expand(ctx, knowledgeBaseData, &apiObject.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &apiObject, diags
+
+	case !data.LambdaFunction.IsNull():
+		lambdaFunctionData, d := data.LambdaFunction.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var apiObject awstypes.FlowNodeConfigurationMemberLambdaFunction
+		diags.Append(fwflex.Expand(ctx, lambdaFunctionData, &apiObject.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &apiObject, diags
+
+	case !data.Lex.IsNull():
+		lexData, d := data.Lex.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var apiObject awstypes.FlowNodeConfigurationMemberLex
+		diags.Append(fwflex.Expand(ctx, lexData, &apiObject.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &apiObject, diags
+
+	case !data.Prompt.IsNull():
+		promptData, d := data.Prompt.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		sourceConfiguration, d := expandPromptFlowNodeSourceConfiguration(ctx, promptData.SourceConfiguration)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &awstypes.FlowNodeConfigurationMemberPrompt{
+			Value: awstypes.PromptFlowNodeConfiguration{
+				SourceConfiguration: sourceConfiguration,
+			},
+		}, diags
+
+	case !data.Retrieval.IsNull():
+		retrievalData, d := data.Retrieval.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		s3Data, d := expandFlowNodeS3Configuration(ctx, retrievalData.ServiceConfiguration)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		apiObject := &awstypes.FlowNodeConfigurationMemberRetrieval{}
+		if s3Data != nil {
+			apiObject.Value.ServiceConfiguration = &awstypes.RetrievalFlowNodeServiceConfigurationMemberS3{
+				Value: awstypes.RetrievalFlowNodeS3Configuration{
+					BucketName: fwflex.StringFromFramework(ctx, s3Data.BucketName),
+				},
+			}
+		}
+
+		return apiObject, diags
+
+	case !data.Storage.IsNull():
+		storageData, d := data.Storage.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		s3Data, d := expandFlowNodeS3Configuration(ctx, storageData.ServiceConfiguration)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		apiObject := &awstypes.FlowNodeConfigurationMemberStorage{}
+		if s3Data != nil {
+			apiObject.Value.ServiceConfiguration = &awstypes.StorageFlowNodeServiceConfigurationMemberS3{
+				Value: awstypes.StorageFlowNodeS3Configuration{
+					BucketName: fwflex.StringFromFramework(ctx, s3Data.BucketName),
+				},
+			}
+		}
+
+		return apiObject, diags
+	}
+
+	return nil, diags
+}
+
+func expandPromptFlowNodeSourceConfiguration(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[promptFlowNodeSourceConfigurationModel]) (awstypes.PromptFlowNodeSourceConfiguration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	data, d := tfList.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || data == nil {
+		return nil, diags
+	}
+
+	switch {
+	case !data.Inline.IsNull():
+		inlineData, d := data.Inline.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		apiObject := &awstypes.PromptFlowNodeSourceConfigurationMemberInline{
+			Value: awstypes.PromptFlowNodeInlineConfiguration{
+				ModelId:      fwflex.StringFromFramework(ctx, inlineData.ModelID),
+				TemplateType: inlineData.TemplateType.ValueEnum(),
+			},
+		}
+
+		apiObject.Value.InferenceConfiguration, d = expandPromptInferenceConfiguration(ctx, inlineData.InferenceConfiguration)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		apiObject.Value.TemplateConfiguration, d = expandPromptTemplateConfiguration(ctx, inlineData.TemplateConfiguration)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return apiObject, diags
+
+	case !data.Resource.IsNull():
+		resourceData, d := data.Resource.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var apiObject awstypes.PromptFlowNodeSourceConfigurationMemberResource
+		diags.Append(fwflex.Expand(ctx, resourceData, &apiObject.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &apiObject, diags
+	}
+
+	return nil, diags
+}
+
+func expandFlowNodeS3Configuration(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[flowNodeServiceConfigurationModel]) (*flowNodeS3ConfigurationModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	data, d := tfList.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || data == nil {
+		return nil, diags
+	}
+
+	s3Data, d := data.S3.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return s3Data, diags
+}
+
+func expandFlowConnectionConfiguration(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[flowConnectionConfigurationModel]) (awstypes.FlowConnectionConfiguration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	data, d := tfList.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || data == nil {
+		return nil, diags
+	}
+
+	switch {
+	case !data.Conditional.IsNull():
+		conditionalData, d := data.Conditional.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var apiObject awstypes.FlowConnectionConfigurationMemberConditional
+		diags.Append(fwflex.Expand(ctx, conditionalData, &apiObject.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &apiObject, diags
+
+	case !data.Data.IsNull():
+		dataData, d := data.Data.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var apiObject awstypes.FlowConnectionConfigurationMemberData
+		diags.Append(fwflex.Expand(ctx, dataData, &apiObject.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &apiObject, diags
+	}
+
+	return nil, diags
+}
+
+func flattenFlowDefinition(ctx context.Context, apiObject *awstypes.FlowDefinition) (fwtypes.ListNestedObjectValueOf[flowDefinitionModel], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if apiObject == nil {
+		return fwtypes.NewListNestedObjectValueOfNull[flowDefinitionModel](ctx), diags
+	}
+
+	var data flowDefinitionModel
+
+	nodesData := make([]*flowNodeModel, 0, len(apiObject.Nodes))
+	for _, node := range apiObject.Nodes {
+		var nodeData flowNodeModel
+		diags.Append(fwflex.Flatten(ctx, &node, &nodeData, fwflex.WithIgnoredFieldNamesAppend("Configuration"))...)
+		if diags.HasError() {
+			return fwtypes.NewListNestedObjectValueOfUnknown[flowDefinitionModel](ctx), diags
+		}
+
+		var d diag.Diagnostics
+		nodeData.Configuration, d = flattenFlowNodeConfiguration(ctx, node.Configuration)
+		diags.Append(d...)
+		if diags.HasError() {
+			return fwtypes.NewListNestedObjectValueOfUnknown[flowDefinitionModel](ctx), diags
+		}
+
+		nodesData = append(nodesData, &nodeData)
+	}
+
+	if len(nodesData) == 0 {
+		data.Nodes = fwtypes.NewListNestedObjectValueOfNull[flowNodeModel](ctx)
+	} else {
+		data.Nodes = fwtypes.NewListNestedObjectValueOfSliceMust(ctx, nodesData)
+	}
+
+	connectionsData := make([]*flowConnectionModel, 0, len(apiObject.Connections))
+	for _, connection := range apiObject.Connections {
+		var connectionData flowConnectionModel
+		diags.Append(fwflex.Flatten(ctx, &connection, &connectionData, fwflex.WithIgnoredFieldNamesAppend("Configuration"))...)
+		if diags.HasError() {
+			return fwtypes.NewListNestedObjectValueOfUnknown[flowDefinitionModel](ctx), diags
+		}
+
+		var d diag.Diagnostics
+		connectionData.Configuration, d = flattenFlowConnectionConfiguration(ctx, connection.Configuration)
+		diags.Append(d...)
+		if diags.HasError() {
+			return fwtypes.NewListNestedObjectValueOfUnknown[flowDefinitionModel](ctx), diags
+		}
+
+		connectionsData = append(connectionsData, &connectionData)
+	}
+
+	if len(connectionsData) == 0 {
+		data.Connections = fwtypes.NewListNestedObjectValueOfNull[flowConnectionModel](ctx)
+	} else {
+		data.Connections = fwtypes.NewListNestedObjectValueOfSliceMust(ctx, connectionsData)
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data), diags
+}
+
+func flattenFlowNodeConfiguration(ctx context.Context, apiObject awstypes.FlowNodeConfiguration) (fwtypes.ListNestedObjectValueOf[flowNodeConfigurationModel], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	data := flowNodeConfigurationModel{
+		Agent:          fwtypes.NewListNestedObjectValueOfNull[agentFlowNodeConfigurationModel](ctx),
+		Condition:      fwtypes.NewListNestedObjectValueOfNull[conditionFlowNodeConfigurationModel](ctx),
+		KnowledgeBase:  fwtypes.NewListNestedObjectValueOfNull[knowledgeBaseFlowNodeConfigurationModel](ctx),
+		LambdaFunction: fwtypes.NewListNestedObjectValueOfNull[lambdaFunctionFlowNodeConfigurationModel](ctx),
+		Lex:            fwtypes.NewListNestedObjectValueOfNull[lexFlowNodeConfigurationModel](ctx),
+		Prompt:         fwtypes.NewListNestedObjectValueOfNull[promptFlowNodeConfigurationModel](ctx),
+		Retrieval:      fwtypes.NewListNestedObjectValueOfNull[retrievalFlowNodeConfigurationModel](ctx),
+		Storage:        fwtypes.NewListNestedObjectValueOfNull[storageFlowNodeConfigurationModel](ctx),
+	}
+
+	switch v := apiObject.(type) {
+	case *awstypes.FlowNodeConfigurationMemberAgent:
+		var agentData agentFlowNodeConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, &v.Value, &agentData)...)
+		if diags.HasError() {
+			return fwtypes.NewListNestedObjectValueOfUnknown[flowNodeConfigurationModel](ctx), diags
+		}
+
+		data.Agent = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &agentData)
+
+	case *awstypes.FlowNodeConfigurationMemberCondition:
+		var conditionData conditionFlowNodeConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, &v.Value, &conditionData)...)
+		if diags.HasError() {
+			return fwtypes.NewListNestedObjectValueOfUnknown[flowNodeConfigurationModel](ctx), diags
+		}
+
+		data.Condition = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &conditionData)
+
+	case *awstypes.FlowNodeConfigurationMemberKnowledgeBase:
+		var knowledgeBaseData knowledgeBaseFlowNodeConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, &v.Value, &knowledgeBaseData)...)
+		if diags.HasError() {
+			return fwtypes.NewListNestedObjectValueOfUnknown[flowNodeConfigurationModel](ctx), diags
+		}
+
+		data.KnowledgeBase = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &knowledgeBaseData)
+
+	case *awstypes.FlowNodeConfigurationMemberLambdaFunction:
+		var lambdaFunctionData lambdaFunctionFlowNodeConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, &v.Value, &lambdaFunctionData)...)
+		if diags.HasError() {
+			return fwtypes.NewListNestedObjectValueOfUnknown[flowNodeConfigurationModel](ctx), diags
+		}
+
+		data.LambdaFunction = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &lambdaFunctionData)
+
+	case *awstypes.FlowNodeConfigurationMemberLex:
+		var lexData lexFlowNodeConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, &v.Value, &lexData)...)
+		if diags.HasError() {
+			return fwtypes.NewListNestedObjectValueOfUnknown[flowNodeConfigurationModel](ctx), diags
+		}
+
+		data.Lex = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &lexData)
+
+	case *awstypes.FlowNodeConfigurationMemberPrompt:
+		sourceConfiguration, d := flattenPromptFlowNodeSourceConfiguration(ctx, v.Value.SourceConfiguration)
+		diags.Append(d...)
+		if diags.HasError() {
+			return fwtypes.NewListNestedObjectValueOfUnknown[flowNodeConfigurationModel](ctx), diags
+		}
+
+		data.Prompt = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &promptFlowNodeConfigurationModel{
+			SourceConfiguration: sourceConfiguration,
+		})
+
+	case *awstypes.FlowNodeConfigurationMemberRetrieval:
+		retrievalData := &retrievalFlowNodeConfigurationModel{
+			ServiceConfiguration: fwtypes.NewListNestedObjectValueOfNull[flowNodeServiceConfigurationModel](ctx),
+		}
+		if v, ok := v.Value.ServiceConfiguration.(*awstypes.RetrievalFlowNodeServiceConfigurationMemberS3); ok {
+			retrievalData.ServiceConfiguration = flattenFlowNodeS3Configuration(ctx, v.Value.BucketName)
+		}
+
+		data.Retrieval = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, retrievalData)
+
+	case *awstypes.FlowNodeConfigurationMemberStorage:
+		storageData := &storageFlowNodeConfigurationModel{
+			ServiceConfiguration: fwtypes.NewListNestedObjectValueOfNull[flowNodeServiceConfigurationModel](ctx),
+		}
+		if v, ok := v.Value.ServiceConfiguration.(*awstypes.StorageFlowNodeServiceConfigurationMemberS3); ok {
+			storageData.ServiceConfiguration = flattenFlowNodeS3Configuration(ctx, v.Value.BucketName)
+		}
+
+		data.Storage = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, storageData)
+
+	default:
+		// Collector, Input, Iterator and Output nodes have no configuration of their own.
+		return fwtypes.NewListNestedObjectValueOfNull[flowNodeConfigurationModel](ctx), diags
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data), diags
+}
+
+func flattenPromptFlowNodeSourceConfiguration(ctx context.Context, apiObject awstypes.PromptFlowNodeSourceConfiguration) (fwtypes.ListNestedObjectValueOf[promptFlowNodeSourceConfigurationModel], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	data := promptFlowNodeSourceConfigurationModel{
+		Inline:   fwtypes.NewListNestedObjectValueOfNull[promptFlowNodeInlineConfigurationModel](ctx),
+		Resource: fwtypes.NewListNestedObjectValueOfNull[promptFlowNodeResourceConfigurationModel](ctx),
+	}
+
+	switch v := apiObject.(type) {
+	case *awstypes.PromptFlowNodeSourceConfigurationMemberInline:
+		inlineData := &promptFlowNodeInlineConfigurationModel{
+			ModelID:      fwflex.StringToFramework(ctx, v.Value.ModelId),
+			TemplateType: fwtypes.StringEnumValue(v.Value.TemplateType),
+		}
+
+		var d diag.Diagnostics
+		inlineData.InferenceConfiguration, d = flattenPromptInferenceConfiguration(ctx, v.Value.InferenceConfiguration)
+		diags.Append(d...)
+		if diags.HasError() {
+			return fwtypes.NewListNestedObjectValueOfUnknown[promptFlowNodeSourceConfigurationModel](ctx), diags
+		}
+
+		inlineData.TemplateConfiguration, d = flattenPromptTemplateConfiguration(ctx, v.Value.TemplateConfiguration)
+		diags.Append(d...)
+		if diags.HasError() {
+			return fwtypes.NewListNestedObjectValueOfUnknown[promptFlowNodeSourceConfigurationModel](ctx), diags
+		}
+
+		data.Inline = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, inlineData)
+
+	case *awstypes.PromptFlowNodeSourceConfigurationMemberResource:
+		var resourceData promptFlowNodeResourceConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, &v.Value, &resourceData)...)
+		if diags.HasError() {
+			return fwtypes.NewListNestedObjectValueOfUnknown[promptFlowNodeSourceConfigurationModel](ctx), diags
+		}
+
+		data.Resource = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &resourceData)
+
+	default:
+		return fwtypes.NewListNestedObjectValueOfNull[promptFlowNodeSourceConfigurationModel](ctx), diags
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data), diags
+}
+
+func flattenFlowNodeS3Configuration(ctx context.Context, bucketName *string) fwtypes.ListNestedObjectValueOf[flowNodeServiceConfigurationModel] {
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &flowNodeServiceConfigurationModel{
+		S3: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &flowNodeS3ConfigurationModel{
+			BucketName: fwflex.StringToFramework(ctx, bucketName),
+		}),
+	})
+}
+
+func flattenFlowConnectionConfiguration(ctx context.Context, apiObject awstypes.FlowConnectionConfiguration) (fwtypes.ListNestedObjectValueOf[flowConnectionConfigurationModel], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	data := flowConnectionConfigurationModel{
+		Conditional: fwtypes.NewListNestedObjectValueOfNull[flowConditionalConnectionConfigurationModel](ctx),
+		Data:        fwtypes.NewListNestedObjectValueOfNull[flowDataConnectionConfigurationModel](ctx),
+	}
+
+	switch v := apiObject.(type) {
+	case *awstypes.FlowConnectionConfigurationMemberConditional:
+		var conditionalData flowConditionalConnectionConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, &v.Value, &conditionalData)...)
+		if diags.HasError() {
+			return fwtypes.NewListNestedObjectValueOfUnknown[flowConnectionConfigurationModel](ctx), diags
+		}
+
+		data.Conditional = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &conditionalData)
+
+	case *awstypes.FlowConnectionConfigurationMemberData:
+		var dataData flowDataConnectionConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, &v.Value, &dataData)...)
+		if diags.HasError() {
+			return fwtypes.NewListNestedObjectValueOfUnknown[flowConnectionConfigurationModel](ctx), diags
+		}
+
+		data.Data = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &dataData)
+
+	default:
+		return fwtypes.NewListNestedObjectValueOfNull[flowConnectionConfigurationModel](ctx), diags
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data), diags
+}
@@ -0,0 +1,174 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	invalidationStatusCompleted = "Completed"
+)
+
+// @Action(name="Create Invalidation")
+func newCreateInvalidationAction(context.Context) (action.ActionWithConfigure, error) {
+	a := &createInvalidationAction{}
+	a.SetDefaultTimeout(15 * time.Minute)
+
+	return a, nil
+}
+
+type createInvalidationAction struct {
+	framework.ActionWithConfigure
+	framework.WithActionTimeout
+}
+
+func (a *createInvalidationAction) Metadata(_ context.Context, request action.MetadataRequest, response *action.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_cloudfront_create_invalidation"
+}
+
+func (a *createInvalidationAction) Schema(ctx context.Context, request action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"caller_reference": schema.StringAttribute{
+				Optional:    true,
+				Description: "Unique value that ensures that the request can't be replayed. Defaults to a generated unique value.",
+			},
+			"distribution_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Identifier of the CloudFront distribution.",
+			},
+			"paths": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				Description: "Paths to invalidate, for example `/*` or `/images/*`.",
+			},
+			names.AttrTimeout: framework.ActionTimeoutAttribute(),
+		},
+	}
+}
+
+func (a *createInvalidationAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	var data createInvalidationActionModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().CloudFrontClient(ctx)
+
+	timeout := a.Timeout(data.Timeout)
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	distributionID := data.DistributionID.ValueString()
+	callerReference := data.CallerReference.ValueString()
+	if callerReference == "" {
+		callerReference = id.UniqueId()
+	}
+	paths := fwflex.ExpandFrameworkStringValueList(ctx, data.Paths)
+
+	framework.SendActionProgress(ctx, response, "Creating CloudFront Distribution (%s) invalidation for %d path(s)", distributionID, len(paths))
+
+	input := cloudfront.CreateInvalidationInput{
+		DistributionId: aws.String(distributionID),
+		InvalidationBatch: &awstypes.InvalidationBatch{
+			CallerReference: aws.String(callerReference),
+			Paths: &awstypes.Paths{
+				Items:    paths,
+				Quantity: aws.Int32(int32(len(paths))),
+			},
+		},
+	}
+
+	output, err := conn.CreateInvalidation(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating CloudFront Distribution (%s) invalidation", distributionID), err.Error())
+		return
+	}
+
+	invalidationID := aws.ToString(output.Invalidation.Id)
+	framework.SendActionProgress(ctx, response, "Waiting for CloudFront Distribution (%s) invalidation (%s) to complete", distributionID, invalidationID)
+
+	err = tfresource.WaitUntil(ctx, timeout, func() (bool, error) {
+		output, err := findInvalidationByTwoPartKey(ctx, conn, distributionID, invalidationID)
+
+		if err != nil {
+			return false, err
+		}
+
+		if status := aws.ToString(output.Status); status != invalidationStatusCompleted {
+			framework.SendActionProgress(ctx, response, "CloudFront Distribution (%s) invalidation (%s) status: %s", distributionID, invalidationID, status)
+			return false, nil
+		}
+
+		return true, nil
+	}, tfresource.WaitOpts{
+		PollInterval: 10 * time.Second,
+	})
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for CloudFront Distribution (%s) invalidation (%s) complete", distributionID, invalidationID), err.Error())
+		return
+	}
+
+	framework.SendActionProgress(ctx, response, "CloudFront Distribution (%s) invalidation (%s) completed", distributionID, invalidationID)
+}
+
+func findInvalidationByTwoPartKey(ctx context.Context, conn *cloudfront.Client, distributionID, id string) (*awstypes.Invalidation, error) {
+	input := &cloudfront.GetInvalidationInput{
+		DistributionId: aws.String(distributionID),
+		Id:             aws.String(id),
+	}
+
+	output, err := conn.GetInvalidation(ctx, input)
+
+	if errs.IsA[*awstypes.NoSuchDistribution](err) || errs.IsA[*awstypes.NoSuchInvalidation](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Invalidation == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Invalidation, nil
+}
+
+type createInvalidationActionModel struct {
+	CallerReference types.String                      `tfsdk:"caller_reference"`
+	DistributionID  types.String                      `tfsdk:"distribution_id"`
+	Paths           fwtypes.ListValueOf[types.String] `tfsdk:"paths"`
+	Timeout         types.Int64                       `tfsdk:"timeout"`
+}
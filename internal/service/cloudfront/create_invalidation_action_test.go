@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcloudfront "github.com/hashicorp/terraform-provider-aws/internal/service/cloudfront"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudFrontCreateInvalidationAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudfront_distribution.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.CloudFrontEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFrontServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDistributionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCreateInvalidationActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCreateInvalidationActionCompleted(ctx, resourceName, rName, "/*"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckCreateInvalidationActionCompleted verifies that the action created an invalidation with the specified caller reference and paths and that it completed.
+func testAccCheckCreateInvalidationActionCompleted(ctx context.Context, n, callerReference string, paths ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFrontClient(ctx)
+
+		pages := cloudfront.NewListInvalidationsPaginator(conn, &cloudfront.ListInvalidationsInput{
+			DistributionId: aws.String(rs.Primary.ID),
+		})
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				return err
+			}
+
+			for _, v := range page.InvalidationList.Items {
+				invalidation, err := tfcloudfront.FindInvalidationByTwoPartKey(ctx, conn, rs.Primary.ID, aws.ToString(v.Id))
+
+				if err != nil {
+					return err
+				}
+
+				if aws.ToString(invalidation.InvalidationBatch.CallerReference) != callerReference {
+					continue
+				}
+
+				if got, want := invalidation.InvalidationBatch.Paths.Items, paths; !slices.Equal(got, want) {
+					return fmt.Errorf("CloudFront Distribution (%s) invalidation (%s) paths = %v, want %v", rs.Primary.ID, aws.ToString(v.Id), got, want)
+				}
+
+				if got, want := aws.ToString(invalidation.Status), "Completed"; got != want {
+					return fmt.Errorf("CloudFront Distribution (%s) invalidation (%s) status = %s, want %s", rs.Primary.ID, aws.ToString(v.Id), got, want)
+				}
+
+				return nil
+			}
+		}
+
+		return fmt.Errorf("CloudFront Distribution (%s) invalidation with caller reference %s not found", rs.Primary.ID, callerReference)
+	}
+}
+
+func testAccCreateInvalidationActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccDistributionConfig_enabled(true, false), fmt.Sprintf(`
+action "aws_cloudfront_create_invalidation" "test" {
+  config {
+    distribution_id  = aws_cloudfront_distribution.test.id
+    caller_reference = %[1]q
+    paths            = ["/*"]
+  }
+}
+
+resource "terraform_data" "test" {
+  input = aws_cloudfront_distribution.test.etag
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_cloudfront_create_invalidation.test]
+    }
+  }
+}
+`, rName))
+}
@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_cloudfront_distributions", name="Distributions")
+func newDistributionsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &distributionsDataSource{}, nil
+}
+
+type distributionsDataSource struct {
+	framework.PluralDataSource[distributionSummaryModel]
+}
+
+func (d *distributionsDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_cloudfront_distributions"
+}
+
+func (d *distributionsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	s, diags := d.PluralSchema(ctx, map[string]schema.Attribute{
+		names.AttrEnabled: schema.BoolAttribute{
+			Optional: true,
+		},
+		"web_acl_id": schema.StringAttribute{
+			Optional: true,
+		},
+	})
+	response.Diagnostics.Append(diags...)
+	response.Schema = s
+}
+
+func (d *distributionsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data distributionsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().CloudFrontClient(ctx)
+
+	input := cloudfront.ListDistributionsInput{}
+
+	response.Diagnostics.Append(d.ReadObjects(ctx, &data.PluralDataSourceModel, "CloudFront Distributions", func(ctx context.Context, fn func(framework.PluralDataSourceObject) bool) error {
+		return listDistributionsPages(ctx, conn, &input, func(page *cloudfront.ListDistributionsOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, v := range page.DistributionList.Items {
+				if !data.Enabled.IsNull() && aws.ToBool(v.Enabled) != data.Enabled.ValueBool() {
+					continue
+				}
+				if webACLID := data.WebACLID.ValueString(); webACLID != "" && aws.ToString(v.WebACLId) != webACLID {
+					continue
+				}
+
+				arn := aws.ToString(v.ARN)
+				// Distributions are named by their domain names.
+				domainNames := []string{aws.ToString(v.DomainName)}
+				if v.Aliases != nil {
+					domainNames = append(domainNames, v.Aliases.Items...)
+				}
+
+				if !fn(framework.PluralDataSourceObject{
+					ARN:    arn,
+					ID:     aws.ToString(v.Id),
+					Names:  domainNames,
+					Source: v,
+					// ListDistributions does not return distribution tags.
+					Tags: func(ctx context.Context) (tftags.KeyValueTags, error) {
+						return listTags(ctx, conn, arn)
+					},
+				}) {
+					return false
+				}
+			}
+
+			return !lastPage
+		})
+	})...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type distributionsDataSourceModel struct {
+	framework.PluralDataSourceModel[distributionSummaryModel]
+	Enabled  types.Bool   `tfsdk:"enabled"`
+	WebACLID types.String `tfsdk:"web_acl_id"`
+}
+
+type distributionSummaryModel struct {
+	ARN              types.String      `tfsdk:"arn"`
+	Comment          types.String      `tfsdk:"comment"`
+	DomainName       types.String      `tfsdk:"domain_name"`
+	Enabled          types.Bool        `tfsdk:"enabled"`
+	HTTPVersion      types.String      `tfsdk:"http_version"`
+	ID               types.String      `tfsdk:"id"`
+	IsIPV6Enabled    types.Bool        `tfsdk:"is_ipv6_enabled"`
+	LastModifiedTime timetypes.RFC3339 `tfsdk:"last_modified_time"`
+	PriceClass       types.String      `tfsdk:"price_class"`
+	Staging          types.Bool        `tfsdk:"staging"`
+	Status           types.String      `tfsdk:"status"`
+	WebACLID         types.String      `tfsdk:"web_acl_id"`
+}
@@ -27,6 +27,7 @@ var (
 	FindFieldLevelEncryptionConfigByID         = findFieldLevelEncryptionConfigByID
 	FindFieldLevelEncryptionProfileByID        = findFieldLevelEncryptionProfileByID
 	FindFunctionByTwoPartKey                   = findFunctionByTwoPartKey
+	FindInvalidationByTwoPartKey               = findInvalidationByTwoPartKey
 	FindKeyGroupByID                           = findKeyGroupByID
 	FindKeyValueStoreByName                    = findKeyValueStoreByName
 	FindMonitoringSubscriptionByDistributionID = findMonitoringSubscriptionByDistributionID
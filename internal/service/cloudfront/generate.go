@@ -3,6 +3,7 @@
 
 //go:generate go run ../../generate/listpages/main.go -AWSSDKVersion=2 -ListOps=ListCachePolicies -InputPaginator=Marker -OutputPaginator=CachePolicyList.NextMarker -- list_cache_policies_pages_gen.go
 //go:generate go run ../../generate/listpages/main.go -AWSSDKVersion=2 -ListOps=ListContinuousDeploymentPolicies -InputPaginator=Marker -OutputPaginator=ContinuousDeploymentPolicyList.NextMarker -- list_continuous_deployment_policies_pages_gen.go
+//go:generate go run ../../generate/listpages/main.go -AWSSDKVersion=2 -ListOps=ListDistributions -InputPaginator=Marker -OutputPaginator=DistributionList.NextMarker -- list_distributions_pages_gen.go
 //go:generate go run ../../generate/listpages/main.go -AWSSDKVersion=2 -ListOps=ListFieldLevelEncryptionConfigs -InputPaginator=Marker -OutputPaginator=FieldLevelEncryptionList.NextMarker -- list_field_level_encryption_configs_pages_gen.go
 //go:generate go run ../../generate/listpages/main.go -AWSSDKVersion=2 -ListOps=ListFieldLevelEncryptionProfiles -InputPaginator=Marker -OutputPaginator=FieldLevelEncryptionProfileList.NextMarker -- list_field_level_encryption_profiles_pages_gen.go
 //go:generate go run ../../generate/listpages/main.go -AWSSDKVersion=2 -ListOps=ListFunctions -InputPaginator=Marker -OutputPaginator=FunctionList.NextMarker -- list_functions_pages_gen.go
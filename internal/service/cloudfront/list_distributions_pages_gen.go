@@ -0,0 +1,27 @@
+// Code generated by "internal/generate/listpages/main.go -AWSSDKVersion=2 -ListOps=ListDistributions -InputPaginator=Marker -OutputPaginator=DistributionList.NextMarker -- list_distributions_pages_gen.go"; DO NOT EDIT.
+
+package cloudfront
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+)
+
+func listDistributionsPages(ctx context.Context, conn *cloudfront.Client, input *cloudfront.ListDistributionsInput, fn func(*cloudfront.ListDistributionsOutput, bool) bool) error {
+	for {
+		output, err := conn.ListDistributions(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.ToString(output.DistributionList.NextMarker) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.Marker = output.DistributionList.NextMarker
+	}
+	return nil
+}
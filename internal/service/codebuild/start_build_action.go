@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package codebuild
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codebuild"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/codebuild/types"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action(name="Start Build")
+func newStartBuildAction(context.Context) (action.ActionWithConfigure, error) {
+	a := &startBuildAction{}
+	a.SetDefaultTimeout(60 * time.Minute)
+
+	return a, nil
+}
+
+type startBuildAction struct {
+	framework.ActionWithConfigure
+	framework.WithActionTimeout
+}
+
+func (a *startBuildAction) Metadata(_ context.Context, request action.MetadataRequest, response *action.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_codebuild_start_build"
+}
+
+func (a *startBuildAction) Schema(ctx context.Context, request action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"environment_variables": schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Plaintext environment variables that override, for this build only, those defined in the build project.",
+			},
+			"project_name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the CodeBuild build project to start.",
+			},
+			"source_version": schema.StringAttribute{
+				Optional:    true,
+				Description: "Version of the build input to be built, for this build only.",
+			},
+			names.AttrTimeout: framework.ActionTimeoutAttribute(),
+		},
+	}
+}
+
+func (a *startBuildAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	var data startBuildActionModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().CodeBuildClient(ctx)
+
+	timeout := a.Timeout(data.Timeout)
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	projectName := data.ProjectName.ValueString()
+	input := codebuild.StartBuildInput{
+		ProjectName:   aws.String(projectName),
+		SourceVersion: data.SourceVersion.ValueStringPointer(),
+	}
+
+	for k, v := range fwflex.ExpandFrameworkStringValueMap(ctx, data.EnvironmentVariables) {
+		input.EnvironmentVariablesOverride = append(input.EnvironmentVariablesOverride, awstypes.EnvironmentVariable{
+			Name:  aws.String(k),
+			Type:  awstypes.EnvironmentVariableTypePlaintext,
+			Value: aws.String(v),
+		})
+	}
+
+	framework.SendActionProgress(ctx, response, "Starting CodeBuild Project (%s) build", projectName)
+
+	output, err := conn.StartBuild(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("starting CodeBuild Project (%s) build", projectName), err.Error())
+		return
+	}
+
+	buildID := aws.ToString(output.Build.Id)
+	framework.SendActionProgress(ctx, response, "Waiting for CodeBuild Build (%s) to complete", buildID)
+
+	err = tfresource.WaitUntil(ctx, timeout, func() (bool, error) {
+		build, err := findBuildByID(ctx, conn, buildID)
+
+		if err != nil {
+			return false, err
+		}
+
+		switch status := build.BuildStatus; status {
+		case awstypes.StatusTypeSucceeded:
+			return true, nil
+		case awstypes.StatusTypeInProgress:
+			framework.SendActionProgress(ctx, response, "CodeBuild Build (%s) phase: %s", buildID, aws.ToString(build.CurrentPhase))
+			return false, nil
+		default:
+			return false, fmt.Errorf("CodeBuild Build (%s) %s", buildID, status)
+		}
+	}, tfresource.WaitOpts{
+		PollInterval: 15 * time.Second,
+	})
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for CodeBuild Build (%s) complete", buildID), err.Error())
+		return
+	}
+
+	framework.SendActionProgress(ctx, response, "CodeBuild Build (%s) succeeded", buildID)
+}
+
+func findBuildByID(ctx context.Context, conn *codebuild.Client, id string) (*awstypes.Build, error) {
+	input := &codebuild.BatchGetBuildsInput{
+		Ids: []string{id},
+	}
+
+	output, err := conn.BatchGetBuilds(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return tfresource.AssertSingleValueResult(output.Builds)
+}
+
+type startBuildActionModel struct {
+	EnvironmentVariables fwtypes.MapValueOf[types.String] `tfsdk:"environment_variables"`
+	ProjectName          types.String                     `tfsdk:"project_name"`
+	SourceVersion        types.String                     `tfsdk:"source_version"`
+	Timeout              types.Int64                      `tfsdk:"timeout"`
+}
@@ -0,0 +1,146 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package codebuild_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codebuild"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/codebuild/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcodebuild "github.com/hashicorp/terraform-provider-aws/internal/service/codebuild"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCodeBuildStartBuildAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_codebuild_project.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeBuildServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStartBuildActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStartBuildActionSucceeded(ctx, resourceName, "TEST_VALUE", rName),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckStartBuildActionSucceeded verifies that the action started a build of the project with the specified
+// environment variable override and that the build succeeded.
+func testAccCheckStartBuildActionSucceeded(ctx context.Context, n, name, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CodeBuildClient(ctx)
+
+		projectName := rs.Primary.Attributes[names.AttrName]
+		pages := codebuild.NewListBuildsForProjectPaginator(conn, &codebuild.ListBuildsForProjectInput{
+			ProjectName: aws.String(projectName),
+		})
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				return err
+			}
+
+			for _, id := range page.Ids {
+				build, err := tfcodebuild.FindBuildByID(ctx, conn, id)
+
+				if err != nil {
+					return err
+				}
+
+				for _, v := range build.Environment.EnvironmentVariables {
+					if aws.ToString(v.Name) != name || aws.ToString(v.Value) != value {
+						continue
+					}
+
+					if got, want := build.BuildStatus, awstypes.StatusTypeSucceeded; got != want {
+						return fmt.Errorf("CodeBuild Build (%s) status = %s, want %s", id, got, want)
+					}
+
+					return nil
+				}
+			}
+		}
+
+		return fmt.Errorf("CodeBuild Project (%s) build with environment variable %s=%s not found", projectName, name, value)
+	}
+}
+
+func testAccStartBuildActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccProjectConfig_baseServiceRole(rName), fmt.Sprintf(`
+resource "aws_codebuild_project" "test" {
+  depends_on = [aws_iam_role_policy.test]
+
+  name         = %[1]q
+  service_role = aws_iam_role.test.arn
+
+  artifacts {
+    type = "NO_ARTIFACTS"
+  }
+
+  environment {
+    compute_type = "BUILD_GENERAL1_SMALL"
+    image        = "aws/codebuild/amazonlinux2-x86_64-standard:5.0"
+    type         = "LINUX_CONTAINER"
+  }
+
+  source {
+    type      = "NO_SOURCE"
+    buildspec = <<EOF
+version: 0.2
+phases:
+  build:
+    commands:
+      - echo $TEST_VALUE
+EOF
+  }
+}
+
+action "aws_codebuild_start_build" "test" {
+  config {
+    project_name = aws_codebuild_project.test.name
+
+    environment_variables = {
+      TEST_VALUE = %[1]q
+    }
+  }
+}
+
+resource "terraform_data" "test" {
+  input = aws_codebuild_project.test.arn
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_codebuild_start_build.test]
+    }
+  }
+}
+`, rName))
+}
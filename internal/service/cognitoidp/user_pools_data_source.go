@@ -59,7 +59,7 @@ func dataSourceUserPoolsRead(ctx context.Context, d *schema.ResourceData, meta i
 		arn := arn.ARN{
 			Partition: meta.(*conns.AWSClient).Partition,
 			Service:   "cognito-idp",
-			Region:    meta.(*conns.AWSClient).Region,
+			Region:    meta.(*conns.AWSClient).Region(ctx),
 			AccountID: meta.(*conns.AWSClient).AccountID,
 			Resource:  "userpool/" + userPoolID,
 		}.String()
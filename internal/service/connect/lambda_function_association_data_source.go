@@ -48,7 +48,7 @@ func dataSourceLambdaFunctionAssociationRead(ctx context.Context, d *schema.Reso
 		return sdkdiag.AppendErrorf(diags, "finding Connect Lambda Function Association by ARN (%s): not found", functionArn)
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).Region(ctx))
 	d.Set(names.AttrFunctionARN, functionArn)
 	d.Set(names.AttrInstanceID, instanceID)
 
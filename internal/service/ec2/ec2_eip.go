@@ -234,7 +234,7 @@ func resourceEIPRead(ctx context.Context, d *schema.ResourceData, meta interface
 	address := outputRaw.(*types.Address)
 	allocationID := aws.ToString(address.AllocationId)
 	d.Set("allocation_id", allocationID)
-	d.Set(names.AttrARN, eipARN(meta.(*conns.AWSClient), allocationID))
+	d.Set(names.AttrARN, eipARN(ctx, meta.(*conns.AWSClient), allocationID))
 	d.Set(names.AttrAssociationID, address.AssociationId)
 	d.Set("carrier_ip", address.CarrierIp)
 	d.Set("customer_owned_ip", address.CustomerOwnedIp)
@@ -414,11 +414,11 @@ func disassociateEIP(ctx context.Context, conn *ec2.Client, associationID string
 	return nil
 }
 
-func eipARN(c *conns.AWSClient, allocationID string) string {
+func eipARN(ctx context.Context, c *conns.AWSClient, allocationID string) string {
 	return arn.ARN{
 		Partition: c.Partition,
 		Service:   names.EC2,
-		Region:    c.Region,
+		Region:    c.Region(ctx),
 		AccountID: c.AccountID,
 		Resource:  "elastic-ip/" + allocationID,
 	}.String()
@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_instance", name="Instance")
+func newInstanceListResource(context.Context) (list.ListResourceWithConfigure, error) {
+	return &instanceListResource{}, nil
+}
+
+type instanceListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+func (l *instanceListResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_instance"
+}
+
+func (l *instanceListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			names.AttrNamePrefix: listschema.StringAttribute{
+				Optional:    true,
+				Description: "Prefix of the value of the instances' `Name` tag.",
+			},
+			names.AttrTags: listschema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Tags that the instances must have.",
+			},
+		},
+	}
+}
+
+func (l *instanceListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var data instanceListResourceModel
+	if diags := request.Config.Get(ctx, &data); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	conn := l.Meta().EC2Client(ctx)
+
+	input := ec2.DescribeInstancesInput{
+		// Terminated instances cannot be managed.
+		Filters: []awstypes.Filter{
+			newFilterV2("instance-state-name", enum.Slice(
+				awstypes.InstanceStateNamePending,
+				awstypes.InstanceStateNameRunning,
+				awstypes.InstanceStateNameStopping,
+				awstypes.InstanceStateNameStopped,
+			)),
+		},
+	}
+	input.Filters = append(input.Filters, newTagFilterListV2(
+		TagsV2(tftags.New(ctx, fwflex.ExpandFrameworkStringValueMap(ctx, data.Tags))),
+	)...)
+	if v := data.NamePrefix.ValueString(); v != "" {
+		input.Filters = append(input.Filters, newFilterV2("tag:Name", []string{v + "*"}))
+	}
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		err := describeInstancesPages(ctx, conn, &input, func(page *ec2.DescribeInstancesOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, reservation := range page.Reservations {
+				for _, v := range reservation.Instances {
+					id := aws.ToString(v.InstanceId)
+
+					d := l.ResourceData()
+					d.SetId(id)
+
+					result := request.NewListResult(ctx)
+					result.DisplayName = id
+					if name := keyValueTagsV2(ctx, v.Tags).KeyValue("Name"); name != nil {
+						result.DisplayName = aws.ToString(name)
+					}
+
+					l.SetResult(ctx, request.IncludeResource, d, &result)
+					if result.Diagnostics.HasError() {
+						yield(result)
+						return false
+					}
+
+					// Terminated while being listed.
+					if d.Id() == "" {
+						continue
+					}
+
+					if !yield(result) {
+						return false
+					}
+				}
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			var result list.ListResult
+			result.Diagnostics.AddError("listing EC2 Instances", err.Error())
+			yield(result)
+		}
+	}
+}
+
+type instanceListResourceModel struct {
+	NamePrefix types.String `tfsdk:"name_prefix"`
+	Tags       types.Map    `tfsdk:"tags"`
+}
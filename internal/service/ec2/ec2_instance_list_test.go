@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEC2InstanceList_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceListConfig_basic(rName),
+			},
+			{
+				Query:  true,
+				Config: testAccInstanceListQuery_basic(rName),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("aws_instance.test", 1),
+					querycheck.ExpectResourceDisplayName("aws_instance.test", queryfilter.ByDisplayName(knownvalue.StringExact(rName)), knownvalue.StringExact(rName)),
+					querycheck.ExpectLength("aws_instance.tags", 1),
+					querycheck.ExpectLength("aws_instance.no_match", 0),
+				},
+			},
+		},
+	})
+}
+
+func testAccInstanceListConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(),
+		acctest.AvailableEC2InstanceTypeForRegion("t3.micro", "t2.micro", "t1.micro", "m1.small"),
+		fmt.Sprintf(`
+resource "aws_instance" "test" {
+  ami           = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+  instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccInstanceListQuery_basic(rName string) string {
+	return fmt.Sprintf(`
+provider "aws" {}
+
+list "aws_instance" "test" {
+  provider = aws
+
+  config {
+    name_prefix = %[1]q
+  }
+}
+
+list "aws_instance" "tags" {
+  provider = aws
+
+  config {
+    name_prefix = %[1]q
+
+    tags = {
+      Name = %[1]q
+    }
+  }
+}
+
+list "aws_instance" "no_match" {
+  provider = aws
+
+  config {
+    name_prefix = %[1]q
+
+    tags = {
+      Name = "%[1]s-no-match"
+    }
+  }
+}
+`, rName)
+}
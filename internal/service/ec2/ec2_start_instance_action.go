@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action(name="Start Instance")
+func newStartInstanceAction(context.Context) (action.ActionWithConfigure, error) {
+	a := &startInstanceAction{}
+	a.SetDefaultTimeout(10 * time.Minute)
+
+	return a, nil
+}
+
+type startInstanceAction struct {
+	framework.ActionWithConfigure
+	framework.WithActionTimeout
+}
+
+func (a *startInstanceAction) Metadata(_ context.Context, request action.MetadataRequest, response *action.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_ec2_start_instance"
+}
+
+func (a *startInstanceAction) Schema(ctx context.Context, request action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrInstanceID: schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the EC2 instance to start.",
+			},
+			names.AttrTimeout: framework.ActionTimeoutAttribute(),
+		},
+	}
+}
+
+func (a *startInstanceAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	var data startInstanceActionModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().EC2Client(ctx)
+
+	timeout := a.Timeout(data.Timeout)
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	instanceID := data.InstanceID.ValueString()
+	framework.SendActionProgress(ctx, response, "Starting EC2 Instance (%s)", instanceID)
+
+	input := ec2.StartInstancesInput{
+		InstanceIds: []string{instanceID},
+	}
+
+	_, err := conn.StartInstances(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("starting EC2 Instance (%s)", instanceID), err.Error())
+		return
+	}
+
+	if err := waitInstanceStateForAction(ctx, conn, instanceID, awstypes.InstanceStateNameRunning, timeout, response); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for EC2 Instance (%s) start", instanceID), err.Error())
+		return
+	}
+
+	framework.SendActionProgress(ctx, response, "EC2 Instance (%s) started", instanceID)
+}
+
+type startInstanceActionModel struct {
+	InstanceID types.String `tfsdk:"instance_id"`
+	Timeout    types.Int64  `tfsdk:"timeout"`
+}
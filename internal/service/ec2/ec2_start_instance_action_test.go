@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEC2StartInstanceAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStartInstanceActionConfig_stopped(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceActionState(ctx, resourceName, awstypes.InstanceStateNameStopped),
+				),
+			},
+			{
+				// Removing aws_ec2_instance_state leaves the instance stopped.
+				Config: testAccStartInstanceActionConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceActionState(ctx, resourceName, awstypes.InstanceStateNameRunning),
+				),
+			},
+		},
+	})
+}
+
+func testAccStartInstanceActionConfig_stopped() string {
+	return acctest.ConfigCompose(testAccInstanceActionConfig_base(), `
+resource "aws_ec2_instance_state" "test" {
+  instance_id = aws_instance.test.id
+  state       = "stopped"
+}
+`)
+}
+
+func testAccStartInstanceActionConfig_basic() string {
+	return acctest.ConfigCompose(testAccInstanceActionConfig_base(), `
+action "aws_ec2_start_instance" "test" {
+  config {
+    instance_id = aws_instance.test.id
+  }
+}
+
+resource "terraform_data" "test" {
+  input = aws_instance.test.id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_ec2_start_instance.test]
+    }
+  }
+}
+`)
+}
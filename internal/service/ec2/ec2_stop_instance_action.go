@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action(name="Stop Instance")
+func newStopInstanceAction(context.Context) (action.ActionWithConfigure, error) {
+	a := &stopInstanceAction{}
+	a.SetDefaultTimeout(10 * time.Minute)
+
+	return a, nil
+}
+
+type stopInstanceAction struct {
+	framework.ActionWithConfigure
+	framework.WithActionTimeout
+}
+
+func (a *stopInstanceAction) Metadata(_ context.Context, request action.MetadataRequest, response *action.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_ec2_stop_instance"
+}
+
+func (a *stopInstanceAction) Schema(ctx context.Context, request action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"force": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to force the instance to stop without flushing file system caches or metadata.",
+			},
+			names.AttrInstanceID: schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the EC2 instance to stop.",
+			},
+			names.AttrTimeout: framework.ActionTimeoutAttribute(),
+		},
+	}
+}
+
+func (a *stopInstanceAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	var data stopInstanceActionModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().EC2Client(ctx)
+
+	timeout := a.Timeout(data.Timeout)
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	instanceID := data.InstanceID.ValueString()
+	framework.SendActionProgress(ctx, response, "Stopping EC2 Instance (%s)", instanceID)
+
+	input := ec2.StopInstancesInput{
+		Force:       data.Force.ValueBoolPointer(),
+		InstanceIds: []string{instanceID},
+	}
+
+	_, err := conn.StopInstances(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("stopping EC2 Instance (%s)", instanceID), err.Error())
+		return
+	}
+
+	if err := waitInstanceStateForAction(ctx, conn, instanceID, awstypes.InstanceStateNameStopped, timeout, response); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for EC2 Instance (%s) stop", instanceID), err.Error())
+		return
+	}
+
+	framework.SendActionProgress(ctx, response, "EC2 Instance (%s) stopped", instanceID)
+}
+
+// waitInstanceStateForAction waits for an EC2 instance to reach the specified state, reporting progress to Terraform.
+func waitInstanceStateForAction(ctx context.Context, conn *ec2.Client, id string, target awstypes.InstanceStateName, timeout time.Duration, response *action.InvokeResponse) error {
+	return tfresource.WaitUntil(ctx, timeout, func() (bool, error) {
+		output, err := findInstanceByID(ctx, conn, id)
+
+		if err != nil {
+			return false, err
+		}
+
+		if state := output.State.Name; state != target {
+			framework.SendActionProgress(ctx, response, "EC2 Instance (%s) state: %s", id, state)
+			return false, nil
+		}
+
+		return true, nil
+	}, tfresource.WaitOpts{
+		PollInterval: 10 * time.Second,
+	})
+}
+
+type stopInstanceActionModel struct {
+	Force      types.Bool   `tfsdk:"force"`
+	InstanceID types.String `tfsdk:"instance_id"`
+	Timeout    types.Int64  `tfsdk:"timeout"`
+}
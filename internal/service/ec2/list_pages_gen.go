@@ -1,4 +1,4 @@
-// Code generated by "internal/generate/listpages/main.go -ListOps=DescribeSpotFleetInstances,DescribeSpotFleetRequestHistory,DescribeVpcEndpointServices -AWSSDKVersion=2"; DO NOT EDIT.
+// Code generated by "internal/generate/listpages/main.go -ListOps=DescribeInstances,DescribeSecurityGroups,DescribeSpotFleetInstances,DescribeSpotFleetRequestHistory,DescribeVpcEndpointServices -AWSSDKVersion=2"; DO NOT EDIT.
 
 package ec2
 
@@ -9,6 +9,38 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 )
 
+func describeInstancesPages(ctx context.Context, conn *ec2.Client, input *ec2.DescribeInstancesInput, fn func(*ec2.DescribeInstancesOutput, bool) bool) error {
+	for {
+		output, err := conn.DescribeInstances(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.ToString(output.NextToken) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+	return nil
+}
+func describeSecurityGroupsPages(ctx context.Context, conn *ec2.Client, input *ec2.DescribeSecurityGroupsInput, fn func(*ec2.DescribeSecurityGroupsOutput, bool) bool) error {
+	for {
+		output, err := conn.DescribeSecurityGroups(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.ToString(output.NextToken) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+	return nil
+}
 func describeSpotFleetInstancesPages(ctx context.Context, conn *ec2.Client, input *ec2.DescribeSpotFleetInstancesInput, fn func(*ec2.DescribeSpotFleetInstancesOutput, bool) bool) error {
 	for {
 		output, err := conn.DescribeSpotFleetInstances(ctx, input)
@@ -12,6 +12,19 @@ import (
 
 type servicePackage struct{}
 
+func (p *servicePackage) Actions(ctx context.Context) []*types.ServicePackageAction {
+	return []*types.ServicePackageAction{
+		{
+			Factory: newStartInstanceAction,
+			Name:    "Start Instance",
+		},
+		{
+			Factory: newStopInstanceAction,
+			Name:    "Stop Instance",
+		},
+	}
+}
+
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
 	return []*types.ServicePackageFrameworkDataSource{
 		{
@@ -499,6 +512,21 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 	}
 }
 
+func (p *servicePackage) SDKListResources(ctx context.Context) []*types.ServicePackageSDKListResource {
+	return []*types.ServicePackageSDKListResource{
+		{
+			Factory:  newInstanceListResource,
+			TypeName: "aws_instance",
+			Name:     "Instance",
+		},
+		{
+			Factory:  newSecurityGroupListResource,
+			TypeName: "aws_security_group",
+			Name:     "Security Group",
+		},
+	}
+}
+
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
 		{
@@ -937,6 +965,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			},
+			Identity: &types.ServicePackageResourceIdentity{
+				Attributes: []string{
+					names.AttrID,
+				},
+			},
 		},
 		{
 			Factory:  ResourceInternetGateway,
@@ -1044,6 +1077,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			},
+			Identity: &types.ServicePackageResourceIdentity{
+				Attributes: []string{
+					names.AttrID,
+				},
+			},
 		},
 		{
 			Factory:  ResourceSecurityGroupRule,
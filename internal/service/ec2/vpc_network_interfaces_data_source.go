@@ -68,7 +68,7 @@ func dataSourceNetworkInterfacesRead(ctx context.Context, d *schema.ResourceData
 		networkInterfaceIDs = append(networkInterfaceIDs, aws.StringValue(v.NetworkInterfaceId))
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).Region(ctx))
 	d.Set(names.AttrIDs, networkInterfaceIDs)
 
 	return diags
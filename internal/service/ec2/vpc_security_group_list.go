@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_security_group", name="Security Group")
+func newSecurityGroupListResource(context.Context) (list.ListResourceWithConfigure, error) {
+	return &securityGroupListResource{}, nil
+}
+
+type securityGroupListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+func (l *securityGroupListResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_security_group"
+}
+
+func (l *securityGroupListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			names.AttrNamePrefix: listschema.StringAttribute{
+				Optional:    true,
+				Description: "Prefix of the security groups' names.",
+			},
+			names.AttrTags: listschema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Tags that the security groups must have.",
+			},
+		},
+	}
+}
+
+func (l *securityGroupListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var data securityGroupListResourceModel
+	if diags := request.Config.Get(ctx, &data); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	conn := l.Meta().EC2Client(ctx)
+
+	input := ec2.DescribeSecurityGroupsInput{}
+	input.Filters = append(input.Filters, newTagFilterListV2(
+		TagsV2(tftags.New(ctx, fwflex.ExpandFrameworkStringValueMap(ctx, data.Tags))),
+	)...)
+	if v := data.NamePrefix.ValueString(); v != "" {
+		input.Filters = append(input.Filters, newFilterV2("group-name", []string{v + "*"}))
+	}
+
+	if len(input.Filters) == 0 {
+		input.Filters = nil
+	}
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		err := describeSecurityGroupsPages(ctx, conn, &input, func(page *ec2.DescribeSecurityGroupsOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, v := range page.SecurityGroups {
+				d := l.ResourceData()
+				d.SetId(aws.ToString(v.GroupId))
+
+				result := request.NewListResult(ctx)
+				result.DisplayName = aws.ToString(v.GroupName)
+
+				l.SetResult(ctx, request.IncludeResource, d, &result)
+				if result.Diagnostics.HasError() {
+					yield(result)
+					return false
+				}
+
+				// Deleted while being listed.
+				if d.Id() == "" {
+					continue
+				}
+
+				if !yield(result) {
+					return false
+				}
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			var result list.ListResult
+			result.Diagnostics.AddError("listing EC2 Security Groups", err.Error())
+			yield(result)
+		}
+	}
+}
+
+type securityGroupListResourceModel struct {
+	NamePrefix types.String `tfsdk:"name_prefix"`
+	Tags       types.Map    `tfsdk:"tags"`
+}
@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVPCSecurityGroupList_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecurityGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupListConfig_basic(rName),
+			},
+			{
+				Query:  true,
+				Config: testAccVPCSecurityGroupListQuery_basic(rName),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("aws_security_group.test", 1),
+					querycheck.ExpectResourceDisplayName("aws_security_group.test", queryfilter.ByDisplayName(knownvalue.StringExact(rName)), knownvalue.StringExact(rName)),
+					querycheck.ExpectLength("aws_security_group.tags", 1),
+					querycheck.ExpectLength("aws_security_group.no_match", 0),
+				},
+			},
+		},
+	})
+}
+
+func testAccVPCSecurityGroupListConfig_basic(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 0), fmt.Sprintf(`
+resource "aws_security_group" "test" {
+  name   = %[1]q
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccVPCSecurityGroupListQuery_basic(rName string) string {
+	return fmt.Sprintf(`
+provider "aws" {}
+
+list "aws_security_group" "test" {
+  provider = aws
+
+  config {
+    name_prefix = %[1]q
+  }
+}
+
+list "aws_security_group" "tags" {
+  provider = aws
+
+  config {
+    name_prefix = %[1]q
+
+    tags = {
+      Name = %[1]q
+    }
+  }
+}
+
+list "aws_security_group" "no_match" {
+  provider = aws
+
+  config {
+    name_prefix = %[1]q
+
+    tags = {
+      Name = "%[1]s-no-match"
+    }
+  }
+}
+`, rName)
+}
@@ -71,7 +71,7 @@ func dataSourceSubnetsRead(ctx context.Context, d *schema.ResourceData, meta int
 		subnetIDs = append(subnetIDs, aws.StringValue(v.SubnetId))
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).Region(ctx))
 	d.Set(names.AttrIDs, subnetIDs)
 
 	return diags
@@ -262,6 +262,19 @@ func TestAccVPC_tags_ignoreTags(t *testing.T) {
 				Config:   acctest.ConfigIgnoreTagsKeys("ignorekey1") + testAccVPCConfig_tags1(acctest.CtKey1, acctest.CtValue1),
 				PlanOnly: true,
 			},
+			{
+				Config:   acctest.ConfigIgnoreTagsKeyRegexes1(`^ignorekey\d$`) + testAccVPCConfig_tags1(acctest.CtKey1, acctest.CtValue1),
+				PlanOnly: true,
+			},
+			{
+				Config:   acctest.ConfigIgnoreTagsKeyValuePairs1("ignorekey1", "ignorevalue1") + testAccVPCConfig_tags1(acctest.CtKey1, acctest.CtValue1),
+				PlanOnly: true,
+			},
+			{
+				Config:             acctest.ConfigIgnoreTagsKeyValuePairs1("ignorekey1", "othervalue") + testAccVPCConfig_tags1(acctest.CtKey1, acctest.CtValue1),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
 		},
 	})
 }
@@ -7,5 +7,6 @@ package ecs
 var (
 	ResourceTag = resourceTag
 
-	FindTag = findTag
+	FindTag               = findTag
+	ServicesFailuresError = servicesFailuresError
 )
@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// @FrameworkDataSource("aws_ecs_services", name="Services")
+func newServicesDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &servicesDataSource{}, nil
+}
+
+type servicesDataSource struct {
+	framework.PluralDataSource[serviceSummaryModel]
+}
+
+func (d *servicesDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_ecs_services"
+}
+
+func (d *servicesDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	s, diags := d.PluralSchema(ctx, map[string]schema.Attribute{
+		"cluster": schema.StringAttribute{
+			Required: true,
+		},
+		"launch_type": schema.StringAttribute{
+			CustomType: fwtypes.StringEnumType[awstypes.LaunchType](),
+			Optional:   true,
+		},
+		"scheduling_strategy": schema.StringAttribute{
+			CustomType: fwtypes.StringEnumType[awstypes.SchedulingStrategy](),
+			Optional:   true,
+		},
+	})
+	response.Diagnostics.Append(diags...)
+	response.Schema = s
+}
+
+func (d *servicesDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data servicesDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().ECSClient(ctx)
+
+	input := ecs.ListServicesInput{
+		Cluster:            data.Cluster.ValueStringPointer(),
+		LaunchType:         data.LaunchType.ValueEnum(),
+		SchedulingStrategy: data.SchedulingStrategy.ValueEnum(),
+	}
+
+	response.Diagnostics.Append(d.ReadObjects(ctx, &data.PluralDataSourceModel, "ECS Services", func(ctx context.Context, fn func(framework.PluralDataSourceObject) bool) error {
+		var err error
+		errPages := listServicesPages(ctx, conn, &input, func(page *ecs.ListServicesOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			// ListServices returns only the services' ARNs.
+			// DescribeServices describes at most 10 services.
+			for arns := range slices.Chunk(page.ServiceArns, 10) {
+				input := ecs.DescribeServicesInput{
+					Cluster:  data.Cluster.ValueStringPointer(),
+					Include:  []awstypes.ServiceField{awstypes.ServiceFieldTags},
+					Services: arns,
+				}
+
+				var output *ecs.DescribeServicesOutput
+				output, err = conn.DescribeServices(ctx, &input)
+
+				if err != nil {
+					return false
+				}
+
+				if err = servicesFailuresError(output.Failures); err != nil {
+					return false
+				}
+
+				for _, v := range output.Services {
+					tags := keyValueTagsV2(ctx, v.Tags)
+
+					if !fn(framework.PluralDataSourceObject{
+						ARN:    aws.ToString(v.ServiceArn),
+						ID:     aws.ToString(v.ServiceArn),
+						Names:  []string{aws.ToString(v.ServiceName)},
+						Source: v,
+						Tags: func(context.Context) (tftags.KeyValueTags, error) {
+							return tags, nil
+						},
+					}) {
+						return false
+					}
+				}
+			}
+
+			return !lastPage
+		})
+
+		if errPages != nil {
+			return errPages
+		}
+
+		return err
+	})...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// servicesFailuresError returns an error for each service that DescribeServices failed to describe.
+// Services deleted since they were listed are reported as MISSING and are skipped.
+func servicesFailuresError(failures []awstypes.Failure) error {
+	var errs []error
+
+	for _, v := range failures {
+		if reason := aws.ToString(v.Reason); reason != "MISSING" {
+			errs = append(errs, fmt.Errorf("describing ECS Service (%s): %s: %s", aws.ToString(v.Arn), reason, aws.ToString(v.Detail)))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+type servicesDataSourceModel struct {
+	framework.PluralDataSourceModel[serviceSummaryModel]
+	Cluster            types.String                                    `tfsdk:"cluster"`
+	LaunchType         fwtypes.StringEnum[awstypes.LaunchType]         `tfsdk:"launch_type"`
+	SchedulingStrategy fwtypes.StringEnum[awstypes.SchedulingStrategy] `tfsdk:"scheduling_strategy"`
+}
+
+type serviceSummaryModel struct {
+	CreatedAt          timetypes.RFC3339 `tfsdk:"created_at"`
+	DesiredCount       types.Int64       `tfsdk:"desired_count"`
+	LaunchType         types.String      `tfsdk:"launch_type"`
+	PendingCount       types.Int64       `tfsdk:"pending_count"`
+	RunningCount       types.Int64       `tfsdk:"running_count"`
+	SchedulingStrategy types.String      `tfsdk:"scheduling_strategy"`
+	ServiceARN         types.String      `tfsdk:"service_arn"`
+	ServiceName        types.String      `tfsdk:"service_name"`
+	Status             types.String      `tfsdk:"status"`
+	TaskDefinition     types.String      `tfsdk:"task_definition"`
+}
@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfecs "github.com/hashicorp/terraform-provider-aws/internal/service/ecs"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestServicesFailuresError(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		failures    []awstypes.Failure
+		expectError bool
+	}{
+		"no failures": {},
+		"missing": {
+			failures: []awstypes.Failure{
+				{Arn: aws.String("deleted"), Reason: aws.String("MISSING")},
+			},
+		},
+		"access denied": {
+			failures: []awstypes.Failure{
+				{Arn: aws.String("deleted"), Reason: aws.String("MISSING")},
+				{Arn: aws.String("denied"), Reason: aws.String("ACCESS_DENIED"), Detail: aws.String("not authorized")},
+			},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfecs.ServicesFailuresError(testCase.failures)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("ServicesFailuresError() error = %v, want error %t", err, want)
+			}
+		})
+	}
+}
+
+func TestAccECSServicesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_ecs_services.test"
+	resourceName := "aws_ecs_service.test.1"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServicesDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "arns.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arns.0", resourceName, names.AttrID),
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "summaries.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "summaries.0.desired_count", acctest.Ct0),
+					resource.TestCheckResourceAttr(dataSourceName, "summaries.0.service_name", rName+"-1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "summaries.0.task_definition", "aws_ecs_task_definition.test", names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
+func testAccServicesDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_cluster" "test" {
+  name = %[1]q
+}
+
+resource "aws_ecs_task_definition" "test" {
+  family = %[1]q
+
+  container_definitions = jsonencode([{
+    name      = "test"
+    image     = "busybox"
+    cpu       = 10
+    memory    = 128
+    essential = true
+  }])
+}
+
+resource "aws_ecs_service" "test" {
+  count = 2
+
+  name            = "%[1]s-${count.index}"
+  cluster         = aws_ecs_cluster.test.id
+  task_definition = aws_ecs_task_definition.test.arn
+  desired_count   = 0
+
+  tags = {
+    Index = count.index
+  }
+}
+
+data "aws_ecs_services" "test" {
+  cluster    = aws_ecs_cluster.test.name
+  name_regex = "^%[1]s-"
+
+  tags = {
+    Index = "1"
+  }
+
+  depends_on = [aws_ecs_service.test]
+}
+`, rName)
+}
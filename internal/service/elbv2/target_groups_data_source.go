@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elbv2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_lb_target_groups", name="Target Groups")
+func newTargetGroupsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &targetGroupsDataSource{}, nil
+}
+
+type targetGroupsDataSource struct {
+	framework.PluralDataSource[targetGroupSummaryModel]
+}
+
+func (d *targetGroupsDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_lb_target_groups"
+}
+
+func (d *targetGroupsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	s, diags := d.PluralSchema(ctx, map[string]schema.Attribute{
+		"load_balancer_arn": schema.StringAttribute{
+			CustomType: fwtypes.ARNType,
+			Optional:   true,
+		},
+		names.AttrProtocol: schema.StringAttribute{
+			CustomType: fwtypes.StringEnumType[awstypes.ProtocolEnum](),
+			Optional:   true,
+		},
+		"target_type": schema.StringAttribute{
+			CustomType: fwtypes.StringEnumType[awstypes.TargetTypeEnum](),
+			Optional:   true,
+		},
+		names.AttrVPCID: schema.StringAttribute{
+			Optional: true,
+		},
+	})
+	response.Diagnostics.Append(diags...)
+	response.Schema = s
+}
+
+func (d *targetGroupsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data targetGroupsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().ELBV2Client(ctx)
+
+	input := elasticloadbalancingv2.DescribeTargetGroupsInput{
+		LoadBalancerArn: data.LoadBalancerARN.ValueStringPointer(),
+	}
+
+	response.Diagnostics.Append(d.ReadObjects(ctx, &data.PluralDataSourceModel, "ELBv2 Target Groups", func(ctx context.Context, fn func(framework.PluralDataSourceObject) bool) error {
+		return describeTargetGroupsPages(ctx, conn, &input, func(page *elasticloadbalancingv2.DescribeTargetGroupsOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, v := range page.TargetGroups {
+				if protocol := data.Protocol.ValueEnum(); protocol != "" && v.Protocol != protocol {
+					continue
+				}
+				if targetType := data.TargetType.ValueEnum(); targetType != "" && v.TargetType != targetType {
+					continue
+				}
+				if vpcID := data.VPCID.ValueString(); vpcID != "" && aws.ToString(v.VpcId) != vpcID {
+					continue
+				}
+
+				arn := aws.ToString(v.TargetGroupArn)
+
+				if !fn(framework.PluralDataSourceObject{
+					ARN:    arn,
+					ID:     arn,
+					Names:  []string{aws.ToString(v.TargetGroupName)},
+					Source: v,
+					// DescribeTargetGroups does not return target group tags.
+					Tags: func(ctx context.Context) (tftags.KeyValueTags, error) {
+						return listTagsV2(ctx, conn, arn)
+					},
+				}) {
+					return false
+				}
+			}
+
+			return !lastPage
+		})
+	})...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type targetGroupsDataSourceModel struct {
+	framework.PluralDataSourceModel[targetGroupSummaryModel]
+	LoadBalancerARN fwtypes.ARN                                 `tfsdk:"load_balancer_arn"`
+	Protocol        fwtypes.StringEnum[awstypes.ProtocolEnum]   `tfsdk:"protocol"`
+	TargetType      fwtypes.StringEnum[awstypes.TargetTypeEnum] `tfsdk:"target_type"`
+	VPCID           types.String                                `tfsdk:"vpc_id"`
+}
+
+type targetGroupSummaryModel struct {
+	IPAddressType    types.String                      `tfsdk:"ip_address_type"`
+	LoadBalancerARNs fwtypes.ListValueOf[types.String] `tfsdk:"load_balancer_arns"`
+	Port             types.Int64                       `tfsdk:"port"`
+	Protocol         types.String                      `tfsdk:"protocol"`
+	ProtocolVersion  types.String                      `tfsdk:"protocol_version"`
+	TargetGroupARN   types.String                      `tfsdk:"target_group_arn"`
+	TargetGroupName  types.String                      `tfsdk:"target_group_name"`
+	TargetType       types.String                      `tfsdk:"target_type"`
+	VPCID            types.String                      `tfsdk:"vpc_id"`
+}
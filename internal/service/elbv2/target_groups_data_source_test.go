@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elbv2_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccELBV2TargetGroupsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_lb_target_groups.test"
+	resourceName := "aws_lb_target_group.test.1"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ELBV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTargetGroupsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "arns.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arns.0", resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "ids.0", resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "summaries.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "summaries.0.load_balancer_arns.#", acctest.Ct0),
+					resource.TestCheckResourceAttrPair(dataSourceName, "summaries.0.target_group_name", resourceName, names.AttrName),
+					resource.TestCheckResourceAttr(dataSourceName, "summaries.0.target_type", "lambda"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTargetGroupsDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_lb_target_group" "test" {
+  count = 2
+
+  name        = "%[1]s-${count.index}"
+  target_type = "lambda"
+
+  tags = {
+    Index = count.index
+  }
+}
+
+data "aws_lb_target_groups" "test" {
+  name_regex  = "^%[1]s-"
+  target_type = "lambda"
+
+  tags = {
+    Index = "1"
+  }
+
+  depends_on = [aws_lb_target_group.test]
+}
+`, rName)
+}
@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_iam_policies", name="Policies")
+func newPoliciesDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &policiesDataSource{}, nil
+}
+
+type policiesDataSource struct {
+	framework.PluralDataSource[policySummaryModel]
+}
+
+func (d *policiesDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_iam_policies"
+}
+
+func (d *policiesDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	s, diags := d.PluralSchema(ctx, map[string]schema.Attribute{
+		"only_attached": schema.BoolAttribute{
+			Optional: true,
+		},
+		"path_prefix": schema.StringAttribute{
+			Optional: true,
+		},
+		"policy_usage_filter": schema.StringAttribute{
+			CustomType: fwtypes.StringEnumType[awstypes.PolicyUsageType](),
+			Optional:   true,
+		},
+		names.AttrScope: schema.StringAttribute{
+			CustomType: fwtypes.StringEnumType[awstypes.PolicyScopeType](),
+			Optional:   true,
+		},
+	})
+	response.Diagnostics.Append(diags...)
+	response.Schema = s
+}
+
+func (d *policiesDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data policiesDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().IAMClient(ctx)
+
+	input := iam.ListPoliciesInput{
+		OnlyAttached:      data.OnlyAttached.ValueBool(),
+		PathPrefix:        data.PathPrefix.ValueStringPointer(),
+		PolicyUsageFilter: data.PolicyUsageFilter.ValueEnum(),
+		Scope:             data.Scope.ValueEnum(),
+	}
+
+	response.Diagnostics.Append(d.ReadObjects(ctx, &data.PluralDataSourceModel, "IAM Policies", func(ctx context.Context, fn func(framework.PluralDataSourceObject) bool) error {
+		return listPoliciesPages(ctx, conn, &input, func(page *iam.ListPoliciesOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, v := range page.Policies {
+				arn := aws.ToString(v.Arn)
+
+				if !fn(framework.PluralDataSourceObject{
+					ARN:    arn,
+					ID:     aws.ToString(v.PolicyId),
+					Names:  []string{aws.ToString(v.PolicyName)},
+					Source: v,
+					// ListPolicies does not return policy tags.
+					Tags: func(ctx context.Context) (tftags.KeyValueTags, error) {
+						return policyKeyValueTags(ctx, conn, arn)
+					},
+				}) {
+					return false
+				}
+			}
+
+			return !lastPage
+		})
+	})...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type policiesDataSourceModel struct {
+	framework.PluralDataSourceModel[policySummaryModel]
+	OnlyAttached      types.Bool                                   `tfsdk:"only_attached"`
+	PathPrefix        types.String                                 `tfsdk:"path_prefix"`
+	PolicyUsageFilter fwtypes.StringEnum[awstypes.PolicyUsageType] `tfsdk:"policy_usage_filter"`
+	Scope             fwtypes.StringEnum[awstypes.PolicyScopeType] `tfsdk:"scope"`
+}
+
+type policySummaryModel struct {
+	ARN                           types.String      `tfsdk:"arn"`
+	AttachmentCount               types.Int64       `tfsdk:"attachment_count"`
+	CreateDate                    timetypes.RFC3339 `tfsdk:"create_date"`
+	DefaultVersionID              types.String      `tfsdk:"default_version_id"`
+	IsAttachable                  types.Bool        `tfsdk:"is_attachable"`
+	Path                          types.String      `tfsdk:"path"`
+	PermissionsBoundaryUsageCount types.Int64       `tfsdk:"permissions_boundary_usage_count"`
+	PolicyID                      types.String      `tfsdk:"policy_id"`
+	PolicyName                    types.String      `tfsdk:"policy_name"`
+	UpdateDate                    timetypes.RFC3339 `tfsdk:"update_date"`
+}
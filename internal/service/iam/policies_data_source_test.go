@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIAMPoliciesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_iam_policies.test"
+	resourceName := "aws_iam_policy.test.1"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPoliciesDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "arns.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arns.0", resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "ids.0", resourceName, "policy_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "summaries.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "summaries.0.attachment_count", acctest.Ct0),
+					resource.TestCheckResourceAttr(dataSourceName, "summaries.0.path", "/"+rName+"/"),
+					resource.TestCheckResourceAttr(dataSourceName, "summaries.0.policy_name", rName+"-1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPoliciesDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_policy" "test" {
+  count = 2
+
+  name = "%[1]s-${count.index}"
+  path = "/%[1]s/"
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = "ec2:Describe*"
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+
+  tags = {
+    Index = count.index
+  }
+}
+
+data "aws_iam_policies" "test" {
+  scope       = "Local"
+  path_prefix = "/%[1]s/"
+  name_regex  = "-1$"
+
+  tags = {
+    Index = "1"
+  }
+
+  depends_on = [aws_iam_policy.test]
+}
+`, rName)
+}
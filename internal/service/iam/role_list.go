@@ -0,0 +1,138 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_iam_role", name="Role")
+func newRoleListResource(context.Context) (list.ListResourceWithConfigure, error) {
+	return &roleListResource{}, nil
+}
+
+type roleListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+func (l *roleListResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_iam_role"
+}
+
+func (l *roleListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			names.AttrNamePrefix: listschema.StringAttribute{
+				Optional:    true,
+				Description: "Prefix of the roles' names.",
+			},
+			names.AttrPath: listschema.StringAttribute{
+				Optional:    true,
+				Description: "Path prefix of the roles. Defaults to `/`, listing all roles.",
+			},
+			names.AttrTags: listschema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Tags that the roles must have.",
+			},
+		},
+	}
+}
+
+func (l *roleListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var data roleListResourceModel
+	if diags := request.Config.Get(ctx, &data); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	conn := l.Meta().IAMClient(ctx)
+
+	input := iam.ListRolesInput{
+		PathPrefix: data.Path.ValueStringPointer(),
+	}
+	namePrefix := data.NamePrefix.ValueString()
+	// ListRoles does not return role tags.
+	tags := tftags.New(ctx, fwflex.ExpandFrameworkStringValueMap(ctx, data.Tags))
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		err := listRolesPages(ctx, conn, &input, func(page *iam.ListRolesOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, v := range page.Roles {
+				name := aws.ToString(v.RoleName)
+
+				if !strings.HasPrefix(name, namePrefix) {
+					continue
+				}
+
+				if len(tags) > 0 {
+					roleTags, err := roleTags(ctx, conn, name)
+
+					if err != nil {
+						var result list.ListResult
+						result.Diagnostics.AddError(fmt.Sprintf("listing tags for IAM Role (%s)", name), err.Error())
+						yield(result)
+						return false
+					}
+
+					if !KeyValueTags(ctx, roleTags).ContainsAll(tags) {
+						continue
+					}
+				}
+
+				d := l.ResourceData()
+				d.SetId(name)
+				d.Set(names.AttrName, name)
+
+				result := request.NewListResult(ctx)
+				result.DisplayName = name
+
+				l.SetResult(ctx, request.IncludeResource, d, &result)
+				if result.Diagnostics.HasError() {
+					yield(result)
+					return false
+				}
+
+				// Deleted while being listed.
+				if d.Id() == "" {
+					continue
+				}
+
+				if !yield(result) {
+					return false
+				}
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			var result list.ListResult
+			result.Diagnostics.AddError("listing IAM Roles", err.Error())
+			yield(result)
+		}
+	}
+}
+
+type roleListResourceModel struct {
+	NamePrefix types.String `tfsdk:"name_prefix"`
+	Path       types.String `tfsdk:"path"`
+	Tags       types.Map    `tfsdk:"tags"`
+}
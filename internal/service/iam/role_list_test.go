@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIAMRoleList_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRoleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoleListConfig_basic(rName),
+			},
+			{
+				Query:  true,
+				Config: testAccRoleListQuery_basic(rName),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("aws_iam_role.test", 1),
+					querycheck.ExpectResourceDisplayName("aws_iam_role.test", queryfilter.ByDisplayName(knownvalue.StringExact(rName)), knownvalue.StringExact(rName)),
+					querycheck.ExpectLength("aws_iam_role.tags", 1),
+					querycheck.ExpectLength("aws_iam_role.no_match", 0),
+				},
+			},
+		},
+	})
+}
+
+func testAccRoleListConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Principal = {
+        Service = "ec2.${data.aws_partition.current.dns_suffix}"
+      }
+      Effect = "Allow"
+    }]
+  })
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
+
+func testAccRoleListQuery_basic(rName string) string {
+	return fmt.Sprintf(`
+provider "aws" {}
+
+list "aws_iam_role" "test" {
+  provider = aws
+
+  config {
+    name_prefix = %[1]q
+  }
+}
+
+list "aws_iam_role" "tags" {
+  provider = aws
+
+  config {
+    name_prefix = %[1]q
+
+    tags = {
+      Name = %[1]q
+    }
+  }
+}
+
+list "aws_iam_role" "no_match" {
+  provider = aws
+
+  config {
+    name_prefix = %[1]q
+
+    tags = {
+      Name = "%[1]s-no-match"
+    }
+  }
+}
+`, rName)
+}
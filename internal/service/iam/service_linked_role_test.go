@@ -101,7 +101,7 @@ func TestAccIAMServiceLinkedRole_basic(t *testing.T) {
 					arn := arn.ARN{
 						Partition: client.Partition,
 						Service:   "iam",
-						Region:    client.Region,
+						Region:    client.Region(ctx),
 						AccountID: client.AccountID,
 						Resource:  arnResource,
 					}.String()
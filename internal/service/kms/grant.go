@@ -27,6 +27,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -38,6 +39,8 @@ func resourceGrant() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceGrantCreate,
 		ReadWithoutTimeout:   resourceGrantRead,
+		// Only `retry_policy` can be updated in place.
+		UpdateWithoutTimeout: schema.NoopContext,
 		DeleteWithoutTimeout: resourceGrantDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -139,6 +142,7 @@ func resourceGrant() *schema.Resource {
 					verify.ValidServicePrincipal,
 				),
 			},
+			"retry_policy": sdkv2.RetryPolicySchema(),
 		},
 	}
 }
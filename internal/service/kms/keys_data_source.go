@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @FrameworkDataSource("aws_kms_keys", name="Keys")
+func newKeysDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &keysDataSource{}, nil
+}
+
+type keysDataSource struct {
+	framework.PluralDataSource[keySummaryModel]
+}
+
+func (d *keysDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_kms_keys"
+}
+
+func (d *keysDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	s, diags := d.PluralSchema(ctx, map[string]schema.Attribute{
+		"key_manager": schema.StringAttribute{
+			CustomType: fwtypes.StringEnumType[awstypes.KeyManagerType](),
+			Optional:   true,
+		},
+		"key_state": schema.StringAttribute{
+			CustomType: fwtypes.StringEnumType[awstypes.KeyState](),
+			Optional:   true,
+		},
+		"key_usage": schema.StringAttribute{
+			CustomType: fwtypes.StringEnumType[awstypes.KeyUsageType](),
+			Optional:   true,
+		},
+	})
+	response.Diagnostics.Append(diags...)
+	response.Schema = s
+}
+
+func (d *keysDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data keysDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().KMSClient(ctx)
+
+	response.Diagnostics.Append(d.ReadObjects(ctx, &data.PluralDataSourceModel, "KMS Keys", func(ctx context.Context, fn func(framework.PluralDataSourceObject) bool) error {
+		// Keys are named by their aliases.
+		aliases, err := findAliases(ctx, conn, &kms.ListAliasesInput{}, tfslices.PredicateTrue[*awstypes.AliasListEntry]())
+
+		if err != nil {
+			return err
+		}
+
+		aliasNames := make(map[string][]string)
+		for _, v := range aliases {
+			if v.TargetKeyId != nil {
+				aliasNames[aws.ToString(v.TargetKeyId)] = append(aliasNames[aws.ToString(v.TargetKeyId)], aws.ToString(v.AliasName))
+			}
+		}
+
+		input := kms.ListKeysInput{}
+		errPages := listKeysPages(ctx, conn, &input, func(page *kms.ListKeysOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, v := range page.Keys {
+				keyID := aws.ToString(v.KeyId)
+
+				// ListKeys returns only the keys' IDs and ARNs.
+				var key *awstypes.KeyMetadata
+				key, err = findKey(ctx, conn, &kms.DescribeKeyInput{
+					KeyId: aws.String(keyID),
+				})
+
+				if tfresource.NotFound(err) {
+					err = nil
+					continue
+				}
+
+				if err != nil {
+					return false
+				}
+
+				if v := data.KeyManager.ValueEnum(); v != "" && key.KeyManager != v {
+					continue
+				}
+				if v := data.KeyState.ValueEnum(); v != "" && key.KeyState != v {
+					continue
+				}
+				if v := data.KeyUsage.ValueEnum(); v != "" && key.KeyUsage != v {
+					continue
+				}
+
+				if !fn(framework.PluralDataSourceObject{
+					ARN:    aws.ToString(key.Arn),
+					ID:     keyID,
+					Names:  aliasNames[keyID],
+					Source: key,
+					Tags: func(ctx context.Context) (tftags.KeyValueTags, error) {
+						return listTags(ctx, conn, keyID)
+					},
+				}) {
+					return false
+				}
+			}
+
+			return !lastPage
+		})
+
+		if errPages != nil {
+			return errPages
+		}
+
+		return err
+	})...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type keysDataSourceModel struct {
+	framework.PluralDataSourceModel[keySummaryModel]
+	KeyManager fwtypes.StringEnum[awstypes.KeyManagerType] `tfsdk:"key_manager"`
+	KeyState   fwtypes.StringEnum[awstypes.KeyState]       `tfsdk:"key_state"`
+	KeyUsage   fwtypes.StringEnum[awstypes.KeyUsageType]   `tfsdk:"key_usage"`
+}
+
+type keySummaryModel struct {
+	ARN          types.String      `tfsdk:"arn"`
+	CreationDate timetypes.RFC3339 `tfsdk:"creation_date"`
+	Description  types.String      `tfsdk:"description"`
+	Enabled      types.Bool        `tfsdk:"enabled"`
+	KeyID        types.String      `tfsdk:"key_id"`
+	KeyManager   types.String      `tfsdk:"key_manager"`
+	KeySpec      types.String      `tfsdk:"key_spec"`
+	KeyState     types.String      `tfsdk:"key_state"`
+	KeyUsage     types.String      `tfsdk:"key_usage"`
+	MultiRegion  types.Bool        `tfsdk:"multi_region"`
+	Origin       types.String      `tfsdk:"origin"`
+}
@@ -42,6 +42,7 @@ const (
 
 // @SDKResource("aws_lambda_function", name="Function")
 // @Tags(identifierAttribute="arn")
+// @IdentityAttribute("function_name")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/lambda;lambda.GetFunctionOutput")
 // @Testing(importIgnore="filename;last_modified;publish")
 func resourceFunction() *schema.Resource {
@@ -172,9 +173,10 @@ func resourceFunction() *schema.Resource {
 				},
 			},
 			"filename": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket},
+				Type:          schema.TypeString,
+				Optional:      true,
+				AtLeastOneOf:  []string{"filename", "image_uri", names.AttrS3Bucket, "source_dir"},
+				ConflictsWith: []string{"image_uri", names.AttrS3Bucket, "source_dir"},
 			},
 			"function_name": {
 				Type:         schema.TypeString,
@@ -211,9 +213,10 @@ func resourceFunction() *schema.Resource {
 				},
 			},
 			"image_uri": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket},
+				Type:          schema.TypeString,
+				Optional:      true,
+				AtLeastOneOf:  []string{"filename", "image_uri", names.AttrS3Bucket, "source_dir"},
+				ConflictsWith: []string{"filename", names.AttrS3Bucket, "source_dir"},
 			},
 			"invoke_arn": {
 				Type:     schema.TypeString,
@@ -314,6 +317,7 @@ func resourceFunction() *schema.Resource {
 				Default:      -1,
 				ValidateFunc: validation.IntAtLeast(-1),
 			},
+			"retry_policy": sdkv2.RetryPolicySchema(),
 			names.AttrRole: {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -325,10 +329,11 @@ func resourceFunction() *schema.Resource {
 				ValidateDiagFunc: enum.Validate[awstypes.Runtime](),
 			},
 			names.AttrS3Bucket: {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket},
-				RequiredWith: []string{"s3_key"},
+				Type:          schema.TypeString,
+				Optional:      true,
+				AtLeastOneOf:  []string{"filename", "image_uri", names.AttrS3Bucket, "source_dir"},
+				ConflictsWith: []string{"filename", "image_uri"},
+				RequiredWith:  []string{"s3_key"},
 			},
 			"s3_key": {
 				Type:         schema.TypeString,
@@ -338,7 +343,7 @@ func resourceFunction() *schema.Resource {
 			"s3_object_version": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"filename", "image_uri"},
+				ConflictsWith: []string{"filename", "image_uri", "source_dir"},
 			},
 			"signing_job_arn": {
 				Type:     schema.TypeString,
@@ -375,12 +380,31 @@ func resourceFunction() *schema.Resource {
 				Type:             schema.TypeString,
 				Optional:         true,
 				Computed:         true,
+				ConflictsWith:    []string{"source_dir"},
 				DiffSuppressFunc: verify.SuppressMissingOptionalConfigurationBlock,
 			},
 			"source_code_size": {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"source_dir": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				AtLeastOneOf:  []string{"filename", "image_uri", names.AttrS3Bucket, "source_dir"},
+				ConflictsWith: []string{"filename", "image_uri"},
+			},
+			"source_dir_excludes": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				RequiredWith: []string{"source_dir"},
+			},
+			"source_dir_includes": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				RequiredWith: []string{"source_dir"},
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			names.AttrTimeout: {
@@ -459,6 +483,7 @@ func resourceFunction() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			checkHandlerRuntimeForZipFunction,
+			customizeDiffSourceArchive,
 			updateComputedAttributesOnPublish,
 			verify.SetTagsDiff,
 		),
@@ -496,6 +521,17 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 
 		input.Code.ZipFile = zipFile
+	} else if v, ok := d.GetOk("source_dir"); ok {
+		conns.GlobalMutexKV.Lock(mutexKey)
+		defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+		code, err := expandSourceArchiveFunctionCode(ctx, d, meta)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "packaging source directory (%s): %s", v, err)
+		}
+
+		input.Code = code
 	} else if v, ok := d.GetOk("image_uri"); ok {
 		input.Code.ImageUri = aws.String(v.(string))
 	} else {
@@ -677,7 +713,7 @@ func resourceFunctionRead(ctx context.Context, d *schema.ResourceData, meta inte
 	if output.Code != nil {
 		d.Set("image_uri", output.Code.ImageUri)
 	}
-	d.Set("invoke_arn", invokeARN(meta.(*conns.AWSClient), functionARN))
+	d.Set("invoke_arn", invokeARN(ctx, meta.(*conns.AWSClient), functionARN))
 	d.Set(names.AttrKMSKeyARN, function.KMSKeyArn)
 	d.Set("last_modified", function.LastModified)
 	if err := d.Set("layers", flattenLayers(function.Layers)); err != nil {
@@ -722,7 +758,7 @@ func resourceFunctionRead(ctx context.Context, d *schema.ResourceData, meta inte
 
 	if hasQualifier {
 		d.Set("qualified_arn", functionARN)
-		d.Set("qualified_invoke_arn", invokeARN(meta.(*conns.AWSClient), functionARN))
+		d.Set("qualified_invoke_arn", invokeARN(ctx, meta.(*conns.AWSClient), functionARN))
 		d.Set(names.AttrVersion, function.Version)
 	} else {
 		latest, err := findLatestFunctionVersionByName(ctx, conn, d.Id())
@@ -733,7 +769,7 @@ func resourceFunctionRead(ctx context.Context, d *schema.ResourceData, meta inte
 
 		qualifiedARN := aws.ToString(latest.FunctionArn)
 		d.Set("qualified_arn", qualifiedARN)
-		d.Set("qualified_invoke_arn", invokeARN(meta.(*conns.AWSClient), qualifiedARN))
+		d.Set("qualified_invoke_arn", invokeARN(ctx, meta.(*conns.AWSClient), qualifiedARN))
 		d.Set(names.AttrVersion, latest.Version)
 
 		setTagsOut(ctx, output.Tags)
@@ -744,7 +780,7 @@ func resourceFunctionRead(ctx context.Context, d *schema.ResourceData, meta inte
 	// in AWS GovCloud (US)) so we cannot just ignore the error as would typically.
 	// Currently this functionality is not enabled in all Regions and returns ambiguous error codes
 	// (e.g. AccessDeniedException), so we cannot just ignore the error as we would typically.
-	if partition, region := meta.(*conns.AWSClient).Partition, meta.(*conns.AWSClient).Region; partition == names.StandardPartitionID && signerServiceIsAvailable(region) {
+	if partition, region := meta.(*conns.AWSClient).Partition, meta.(*conns.AWSClient).Region(ctx); partition == names.StandardPartitionID && signerServiceIsAvailable(region) {
 		var codeSigningConfigARN string
 
 		// Code Signing is only supported on zip packaged lambda functions.
@@ -962,6 +998,20 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			}
 
 			input.ZipFile = zipFile
+		} else if v, ok := d.GetOk("source_dir"); ok {
+			conns.GlobalMutexKV.Lock(mutexKey)
+			defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+			code, err := expandSourceArchiveFunctionCode(ctx, d, meta)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "packaging source directory (%s): %s", v, err)
+			}
+
+			input.S3Bucket = code.S3Bucket
+			input.S3Key = code.S3Key
+			input.S3ObjectVersion = code.S3ObjectVersion
+			input.ZipFile = code.ZipFile
 		} else if v, ok := d.GetOk("image_uri"); ok {
 			input.ImageUri = aws.String(v.(string))
 		} else {
@@ -1383,6 +1433,42 @@ func needsFunctionConfigUpdate(d sdkv2.ResourceDiffer) bool {
 		d.HasChange("ephemeral_storage")
 }
 
+// expandSourceArchiveFunctionCode packages the function's source directory.
+// If an S3 bucket is configured the package is uploaded to S3, otherwise it is uploaded directly.
+func expandSourceArchiveFunctionCode(ctx context.Context, d *schema.ResourceData, meta interface{}) (*awstypes.FunctionCode, error) {
+	archive, err := expandSourceArchive(d)
+	if err != nil {
+		return nil, err
+	}
+
+	zipFile, err := archive.Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	if v, ok := d.GetOk(names.AttrS3Bucket); ok {
+		bucket, key := v.(string), d.Get("s3_key").(string)
+		version, err := uploadSourceArchive(ctx, meta, bucket, key, zipFile)
+		if err != nil {
+			return nil, err
+		}
+
+		return &awstypes.FunctionCode{
+			S3Bucket:        aws.String(bucket),
+			S3Key:           aws.String(key),
+			S3ObjectVersion: version,
+		}, nil
+	}
+
+	if n := len(zipFile); n > sourceArchiveMaxZipFileSize {
+		return nil, fmt.Errorf("package size (%d bytes) exceeds the maximum for direct upload (%d bytes), set s3_bucket and s3_key to upload via S3", n, sourceArchiveMaxZipFileSize)
+	}
+
+	return &awstypes.FunctionCode{
+		ZipFile: zipFile,
+	}, nil
+}
+
 func readFileContents(v string) ([]byte, error) {
 	filename, err := homedir.Expand(v)
 	if err != nil {
@@ -1398,11 +1484,11 @@ func readFileContents(v string) ([]byte, error) {
 }
 
 // See https://docs.aws.amazon.com/apigateway/latest/developerguide/set-up-lambda-custom-integrations.html.
-func invokeARN(c *conns.AWSClient, functionOrAliasARN string) string {
+func invokeARN(ctx context.Context, c *conns.AWSClient, functionOrAliasARN string) string {
 	return arn.ARN{
 		Partition: c.Partition,
 		Service:   "apigateway",
-		Region:    c.Region,
+		Region:    c.Region(ctx),
 		AccountID: "lambda",
 		Resource:  fmt.Sprintf("path/2015-03-31/functions/%s/invocations", functionOrAliasARN),
 	}.String()
@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_lambda_function", name="Function")
+func newFunctionListResource(context.Context) (list.ListResourceWithConfigure, error) {
+	return &functionListResource{}, nil
+}
+
+type functionListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+func (l *functionListResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_lambda_function"
+}
+
+func (l *functionListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			names.AttrNamePrefix: listschema.StringAttribute{
+				Optional:    true,
+				Description: "Prefix of the functions' names.",
+			},
+			names.AttrTags: listschema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Tags that the functions must have.",
+			},
+		},
+	}
+}
+
+func (l *functionListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var data functionListResourceModel
+	if diags := request.Config.Get(ctx, &data); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	conn := l.Meta().LambdaClient(ctx)
+
+	input := lambda.ListFunctionsInput{}
+	namePrefix := data.NamePrefix.ValueString()
+	// ListFunctions does not return function tags.
+	tags := tftags.New(ctx, fwflex.ExpandFrameworkStringValueMap(ctx, data.Tags))
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		err := listFunctionsPages(ctx, conn, &input, func(page *lambda.ListFunctionsOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, v := range page.Functions {
+				name := aws.ToString(v.FunctionName)
+
+				if !strings.HasPrefix(name, namePrefix) {
+					continue
+				}
+
+				if len(tags) > 0 {
+					functionTags, err := listTags(ctx, conn, aws.ToString(v.FunctionArn))
+
+					if err != nil {
+						var result list.ListResult
+						result.Diagnostics.AddError(fmt.Sprintf("listing tags for Lambda Function (%s)", name), err.Error())
+						yield(result)
+						return false
+					}
+
+					if !functionTags.ContainsAll(tags) {
+						continue
+					}
+				}
+
+				d := l.ResourceData()
+				d.SetId(name)
+				d.Set("function_name", name)
+
+				result := request.NewListResult(ctx)
+				result.DisplayName = name
+
+				l.SetResult(ctx, request.IncludeResource, d, &result)
+				if result.Diagnostics.HasError() {
+					yield(result)
+					return false
+				}
+
+				// Deleted while being listed.
+				if d.Id() == "" {
+					continue
+				}
+
+				if !yield(result) {
+					return false
+				}
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			var result list.ListResult
+			result.Diagnostics.AddError("listing Lambda Functions", err.Error())
+			yield(result)
+		}
+	}
+}
+
+type functionListResourceModel struct {
+	NamePrefix types.String `tfsdk:"name_prefix"`
+	Tags       types.Map    `tfsdk:"tags"`
+}
@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLambdaFunctionList_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionListConfig_basic(rName),
+			},
+			{
+				Query:  true,
+				Config: testAccFunctionListQuery_basic(rName),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("aws_lambda_function.test", 1),
+					querycheck.ExpectResourceDisplayName("aws_lambda_function.test", queryfilter.ByDisplayName(knownvalue.StringExact(rName)), knownvalue.StringExact(rName)),
+					querycheck.ExpectLength("aws_lambda_function.tags", 1),
+					querycheck.ExpectLength("aws_lambda_function.no_match", 0),
+				},
+			},
+		},
+	})
+}
+
+func testAccFunctionListConfig_basic(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigLambdaBase(rName, rName, rName), fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.iam_for_lambda.arn
+  handler       = "exports.example"
+  runtime       = "nodejs16.x"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccFunctionListQuery_basic(rName string) string {
+	return fmt.Sprintf(`
+provider "aws" {}
+
+list "aws_lambda_function" "test" {
+  provider = aws
+
+  config {
+    name_prefix = %[1]q
+  }
+}
+
+list "aws_lambda_function" "tags" {
+  provider = aws
+
+  config {
+    name_prefix = %[1]q
+
+    tags = {
+      Name = %[1]q
+    }
+  }
+}
+
+list "aws_lambda_function" "no_match" {
+  provider = aws
+
+  config {
+    name_prefix = %[1]q
+
+    tags = {
+      Name = "%[1]s-no-match"
+    }
+  }
+}
+`, rName)
+}
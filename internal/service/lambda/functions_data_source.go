@@ -56,7 +56,7 @@ func dataSourceFunctionsRead(ctx context.Context, d *schema.ResourceData, meta i
 		}
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).Region(ctx))
 	d.Set("function_arns", functionARNs)
 	d.Set("function_names", functionNames)
 
@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action(name="Invoke")
+func newInvokeAction(context.Context) (action.ActionWithConfigure, error) {
+	a := &invokeAction{}
+	a.SetDefaultTimeout(15 * time.Minute)
+
+	return a, nil
+}
+
+type invokeAction struct {
+	framework.ActionWithConfigure
+	framework.WithActionTimeout
+}
+
+func (a *invokeAction) Metadata(_ context.Context, request action.MetadataRequest, response *action.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_lambda_invoke"
+}
+
+func (a *invokeAction) Schema(ctx context.Context, request action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"function_name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name, ARN or partial ARN of the Lambda function to invoke.",
+			},
+			"invocation_type": schema.StringAttribute{
+				CustomType:  fwtypes.StringEnumType[awstypes.InvocationType](),
+				Optional:    true,
+				Description: "Invocation type. Defaults to `RequestResponse`.",
+			},
+			"payload": schema.StringAttribute{
+				CustomType:  jsontypes.NormalizedType{},
+				Required:    true,
+				Description: "JSON payload to the Lambda function.",
+			},
+			"qualifier": schema.StringAttribute{
+				Optional:    true,
+				Description: "Version or alias of the Lambda function to invoke. Defaults to `$LATEST`.",
+			},
+			names.AttrTimeout: framework.ActionTimeoutAttribute(),
+		},
+	}
+}
+
+func (a *invokeAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	var data invokeActionModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().LambdaClient(ctx)
+
+	timeout := a.Timeout(data.Timeout)
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	functionName := data.FunctionName.ValueString()
+	framework.SendActionProgress(ctx, response, "Waiting for Lambda Function (%s) to become active", functionName)
+
+	err := tfresource.WaitUntil(ctx, timeout, func() (bool, error) {
+		input := &lambda.GetFunctionInput{
+			FunctionName: aws.String(functionName),
+			Qualifier:    data.Qualifier.ValueStringPointer(),
+		}
+		output, err := findFunction(ctx, conn, input)
+
+		if err != nil {
+			return false, err
+		}
+
+		switch state := output.Configuration.State; state {
+		case awstypes.StateActive:
+			return true, nil
+		case awstypes.StateFailed:
+			return false, fmt.Errorf("Lambda Function (%s) state %s: %s", functionName, state, aws.ToString(output.Configuration.StateReason))
+		default:
+			framework.SendActionProgress(ctx, response, "Lambda Function (%s) state: %s", functionName, state)
+			return false, nil
+		}
+	}, tfresource.WaitOpts{
+		PollInterval: 5 * time.Second,
+	})
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Lambda Function (%s) active", functionName), err.Error())
+		return
+	}
+
+	invocationType := data.InvocationType.ValueEnum()
+	if invocationType == "" {
+		invocationType = awstypes.InvocationTypeRequestResponse
+	}
+
+	framework.SendActionProgress(ctx, response, "Invoking Lambda Function (%s)", functionName)
+
+	input := lambda.InvokeInput{
+		FunctionName:   aws.String(functionName),
+		InvocationType: invocationType,
+		Payload:        []byte(data.Payload.ValueString()),
+		Qualifier:      data.Qualifier.ValueStringPointer(),
+	}
+
+	output, err := conn.Invoke(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("invoking Lambda Function (%s)", functionName), err.Error())
+		return
+	}
+
+	if output.FunctionError != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("invoking Lambda Function (%s)", functionName), fmt.Sprintf("%s: %s", aws.ToString(output.FunctionError), string(output.Payload)))
+		return
+	}
+
+	framework.SendActionProgress(ctx, response, "Lambda Function (%s) invoked successfully (status code %d)", functionName, output.StatusCode)
+}
+
+type invokeActionModel struct {
+	FunctionName   types.String                                `tfsdk:"function_name"`
+	InvocationType fwtypes.StringEnum[awstypes.InvocationType] `tfsdk:"invocation_type"`
+	Payload        jsontypes.Normalized                        `tfsdk:"payload"`
+	Qualifier      types.String                                `tfsdk:"qualifier"`
+	Timeout        types.Int64                                 `tfsdk:"timeout"`
+}
@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLambdaInvokeAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	ssmParameterName := fmt.Sprintf("/tf-test/CRUD/%s", rName)
+	// The test function stores the event in the SSM parameter when invoked with a "delete" action.
+	payloadJSON := `{"key1":"value1","tf":{"action":"delete"}}`
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInvokeActionConfig_basic(rName, ssmParameterName, payloadJSON),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInvokeActionPayload(ctx, ssmParameterName, payloadJSON),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckInvokeActionPayload verifies that the action invoked the Lambda function with the expected payload.
+// The payload is stored in an SSM Parameter by the function. The parameter is removed once read.
+func testAccCheckInvokeActionPayload(ctx context.Context, ssmParameterName, expectedPayload string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMClient(ctx)
+
+		output, err := conn.GetParameter(ctx, &ssm.GetParameterInput{
+			Name: aws.String(ssmParameterName),
+		})
+
+		if err != nil {
+			return fmt.Errorf("reading SSM Parameter (%s): %w", ssmParameterName, err)
+		}
+
+		if err := removeSSMParameter(ctx, conn, ssmParameterName); err != nil {
+			return fmt.Errorf("deleting SSM Parameter (%s): %w", ssmParameterName, err)
+		}
+
+		if got := aws.ToString(output.Parameter.Value); !verify.JSONStringsEqual(got, expectedPayload) {
+			return fmt.Errorf("Lambda Function invoked with %s, expected %s", got, expectedPayload)
+		}
+
+		return nil
+	}
+}
+
+func testAccInvokeActionConfig_basic(rName, ssmParameterName, payloadJSON string) string {
+	return acctest.ConfigCompose(
+		testAccInvocationConfig_function("lambda_invocation_crud", rName, ssmParameterName),
+		testAccInvocationConfig_crudAllowSSM(rName, ssmParameterName),
+		fmt.Sprintf(`
+action "aws_lambda_invoke" "test" {
+  config {
+    function_name = aws_lambda_function.test.function_name
+    payload       = %[1]q
+  }
+}
+
+resource "terraform_data" "test" {
+  depends_on = [aws_iam_role_policy_attachment.test_ssm]
+
+  input = aws_lambda_function.test.version
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_lambda_invoke.test]
+    }
+  }
+}
+`, payloadJSON))
+}
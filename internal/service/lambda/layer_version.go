@@ -83,7 +83,7 @@ func resourceLayerVersion() *schema.Resource {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{names.AttrS3Bucket, "s3_key", "s3_object_version"},
+				ConflictsWith: []string{names.AttrS3Bucket, "s3_key", "s3_object_version", "source_dir"},
 			},
 			"layer_arn": {
 				Type:     schema.TypeString,
@@ -116,7 +116,7 @@ func resourceLayerVersion() *schema.Resource {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"filename"},
+				ConflictsWith: []string{"filename", "source_dir"},
 			},
 			"signing_job_arn": {
 				Type:     schema.TypeString,
@@ -133,20 +133,43 @@ func resourceLayerVersion() *schema.Resource {
 				Optional: true,
 			},
 			"source_code_hash": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
-				ForceNew: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"source_dir"},
 			},
 			"source_code_size": {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"source_dir": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"filename"},
+			},
+			"source_dir_excludes": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				ForceNew:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				RequiredWith: []string{"source_dir"},
+			},
+			"source_dir_includes": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				ForceNew:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				RequiredWith: []string{"source_dir"},
+			},
 			names.AttrVersion: {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
 		},
+
+		CustomizeDiff: customizeDiffSourceArchive,
 	}
 }
 
@@ -156,12 +179,13 @@ func resourceLayerVersionCreate(ctx context.Context, d *schema.ResourceData, met
 
 	layerName := d.Get("layer_name").(string)
 	filename, hasFilename := d.GetOk("filename")
+	sourceDir, hasSourceDir := d.GetOk("source_dir")
 	s3Bucket, bucketOk := d.GetOk(names.AttrS3Bucket)
 	s3Key, keyOk := d.GetOk("s3_key")
 	s3ObjectVersion, versionOk := d.GetOk("s3_object_version")
 
-	if !hasFilename && !bucketOk && !keyOk && !versionOk {
-		return sdkdiag.AppendErrorf(diags, "filename or s3_* attributes must be set")
+	if !hasFilename && !hasSourceDir && !bucketOk && !keyOk && !versionOk {
+		return sdkdiag.AppendErrorf(diags, "filename, source_dir or s3_* attributes must be set")
 	}
 
 	var layerContent *awstypes.LayerVersionContentInput
@@ -177,6 +201,41 @@ func resourceLayerVersionCreate(ctx context.Context, d *schema.ResourceData, met
 		layerContent = &awstypes.LayerVersionContentInput{
 			ZipFile: file,
 		}
+	} else if hasSourceDir {
+		conns.GlobalMutexKV.Lock(mutexLayerKey)
+		defer conns.GlobalMutexKV.Unlock(mutexLayerKey)
+
+		archive, err := expandSourceArchive(d)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "packaging source directory (%s): %s", sourceDir, err)
+		}
+
+		zipFile, err := archive.Bytes()
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "packaging source directory (%s): %s", sourceDir, err)
+		}
+
+		switch {
+		case bucketOk && keyOk:
+			version, err := uploadSourceArchive(ctx, meta, s3Bucket.(string), s3Key.(string), zipFile)
+			if err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+
+			layerContent = &awstypes.LayerVersionContentInput{
+				S3Bucket:        aws.String(s3Bucket.(string)),
+				S3Key:           aws.String(s3Key.(string)),
+				S3ObjectVersion: version,
+			}
+		case bucketOk || keyOk:
+			return sdkdiag.AppendErrorf(diags, "s3_bucket and s3_key must all be set while uploading source_dir via S3")
+		case len(zipFile) > sourceArchiveMaxZipFileSize:
+			return sdkdiag.AppendErrorf(diags, "packaging source directory (%s): package size (%d bytes) exceeds the maximum for direct upload (%d bytes), set s3_bucket and s3_key to upload via S3", sourceDir, len(zipFile), sourceArchiveMaxZipFileSize)
+		default:
+			layerContent = &awstypes.LayerVersionContentInput{
+				ZipFile: zipFile,
+			}
+		}
 	} else {
 		if !bucketOk || !keyOk {
 			return sdkdiag.AppendErrorf(diags, "s3_bucket and s3_key must all be set while using s3 code source")
@@ -0,0 +1,209 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/fileset"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+const (
+	// Maximum size of a deployment package uploaded directly to Lambda.
+	// Larger packages must be uploaded to S3.
+	// See https://docs.aws.amazon.com/lambda/latest/dg/gettingstarted-limits.html.
+	sourceArchiveMaxZipFileSize = 50 * 1024 * 1024
+)
+
+var (
+	// All archive entries have the same modification time so that an archive's contents depend only on the source files.
+	sourceArchiveModified = time.Date(1980, time.January, 1, 0, 0, 0, 0, time.UTC)
+)
+
+// sourceArchive is a deterministic ZIP archive of a source directory.
+type sourceArchive struct {
+	files []sourceArchiveFile
+}
+
+type sourceArchiveFile struct {
+	name string // Slash-separated path relative to the source directory.
+	path string // Path on the local filesystem.
+	mode fs.FileMode
+}
+
+// newSourceArchive returns an archive of the files in the specified directory that match the include and exclude patterns.
+// See fileset.Walk for the pattern syntax.
+func newSourceArchive(dir string, includes, excludes []string) (*sourceArchive, error) {
+	files, err := fileset.Walk(dir, includes, excludes)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no files in %s match the include and exclude patterns", dir)
+	}
+
+	archive := &sourceArchive{}
+
+	for _, v := range files {
+		// Only the executable bit is preserved.
+		mode := fs.FileMode(0o644)
+		if v.Info.Mode()&0o111 != 0 {
+			mode = 0o755
+		}
+
+		archive.files = append(archive.files, sourceArchiveFile{
+			name: v.Name,
+			path: v.Path,
+			mode: mode,
+		})
+	}
+
+	return archive, nil
+}
+
+// Hash returns the Base64-encoded SHA256 hash of the archive's file names, permissions and contents.
+// The hash is independent of how the files are compressed.
+func (a *sourceArchive) Hash() (string, error) {
+	h := sha256.New()
+
+	for _, v := range a.files {
+		f, err := os.Open(v.path)
+		if err != nil {
+			return "", err
+		}
+
+		fmt.Fprintf(h, "%s\x00%o\x00", v.name, v.mode)
+		_, err = io.Copy(h, f)
+		f.Close()
+
+		if err != nil {
+			return "", err
+		}
+
+		h.Write([]byte{0})
+	}
+
+	return base64.StdEncoding.EncodeToString(h.Sum(nil)), nil
+}
+
+// Bytes returns the ZIP archive.
+func (a *sourceArchive) Bytes() ([]byte, error) {
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+
+	for _, v := range a.files {
+		header := &zip.FileHeader{
+			Name:     v.name,
+			Method:   zip.Deflate,
+			Modified: sourceArchiveModified,
+		}
+		header.SetMode(v.mode)
+
+		fw, err := w.CreateHeader(header)
+		if err != nil {
+			return nil, err
+		}
+
+		f, err := os.Open(v.path)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = io.Copy(fw, f)
+		f.Close()
+
+		if err != nil {
+			return nil, fmt.Errorf("archiving %s: %w", v.name, err)
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func expandSourceArchive(d interface {
+	GetOk(string) (any, bool)
+}) (*sourceArchive, error) {
+	dir, ok := d.GetOk("source_dir")
+	if !ok {
+		return nil, nil
+	}
+
+	var includes, excludes []string
+	if v, ok := d.GetOk("source_dir_includes"); ok {
+		includes = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+	if v, ok := d.GetOk("source_dir_excludes"); ok {
+		excludes = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	return newSourceArchive(dir.(string), includes, excludes)
+}
+
+// customizeDiffSourceArchive sets `source_code_hash` to the hash of the `source_dir` contents.
+// There is only a difference when the contents change.
+func customizeDiffSourceArchive(_ context.Context, d *schema.ResourceDiff, meta any) error {
+	if !d.NewValueKnown("source_dir") || !d.NewValueKnown("source_dir_includes") || !d.NewValueKnown("source_dir_excludes") {
+		return d.SetNewComputed("source_code_hash")
+	}
+
+	archive, err := expandSourceArchive(d)
+	if err != nil {
+		return fmt.Errorf("reading source_dir: %w", err)
+	}
+
+	if archive == nil {
+		return nil
+	}
+
+	hash, err := archive.Hash()
+	if err != nil {
+		return fmt.Errorf("hashing source_dir: %w", err)
+	}
+
+	if old, _ := d.GetChange("source_code_hash"); old.(string) == hash {
+		return nil
+	}
+
+	return d.SetNew("source_code_hash", hash)
+}
+
+// uploadSourceArchive uploads a ZIP archive to S3 and returns the new object version, if any.
+func uploadSourceArchive(ctx context.Context, meta any, bucket, key string, body []byte) (*string, error) {
+	uploader := manager.NewUploader(meta.(*conns.AWSClient).S3Client(ctx))
+
+	input := &s3.PutObjectInput{
+		Body:        bytes.NewReader(body),
+		Bucket:      aws.String(bucket),
+		ContentType: aws.String("application/zip"),
+		Key:         aws.String(key),
+	}
+
+	output, err := uploader.Upload(ctx, input)
+
+	if err != nil {
+		return nil, fmt.Errorf("uploading S3 Object (%s) to Bucket (%s): %w", key, bucket, err)
+	}
+
+	return output.VersionID, nil
+}
@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"archive/zip"
+	"bytes"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestSourceArchive(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	for name, contents := range map[string]string{
+		"index.py":           "def handler(event, context): pass\n",
+		"lib/util.py":        "X = 1\n",
+		"tests/test_util.py": "assert True\n",
+		"README.md":          "# Example\n",
+	} {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, []byte(contents), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	archive, err := newSourceArchive(dir, []string{"**/*.py"}, []string{"tests"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b1, err := archive.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	h1, err := archive.Hash()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := zip.NewReader(bytes.NewReader(b1), int64(len(b1)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var names []string
+	for _, f := range r.File {
+		names = append(names, f.Name)
+
+		if got, want := f.Mode().Perm(), os.FileMode(0o644); got != want {
+			t.Errorf("%s mode = %s, want %s", f.Name, got, want)
+		}
+	}
+	if got, want := names, []string{"index.py", "lib/util.py"}; !slices.Equal(got, want) {
+		t.Errorf("archive files = %q, want %q", got, want)
+	}
+
+	// Modification times don't affect the archive.
+	later := time.Now().Add(time.Hour)
+	if err := os.Chtimes(filepath.Join(dir, "index.py"), later, later); err != nil {
+		t.Fatal(err)
+	}
+
+	b2, err := archive.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	h2, err := archive.Hash()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(b1, b2) {
+		t.Error("archive changed after modification time change")
+	}
+	if h1 != h2 {
+		t.Errorf("hash changed after modification time change: %s, %s", h1, h2)
+	}
+
+	// Contents do.
+	if err := os.WriteFile(filepath.Join(dir, "lib", "util.py"), []byte("X = 2\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	h3, err := archive.Hash()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if h1 == h3 {
+		t.Error("hash unchanged after contents change")
+	}
+}
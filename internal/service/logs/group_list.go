@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_cloudwatch_log_group", name="Log Group")
+func newGroupListResource(context.Context) (list.ListResourceWithConfigure, error) {
+	return &groupListResource{}, nil
+}
+
+type groupListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+func (l *groupListResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_cloudwatch_log_group"
+}
+
+func (l *groupListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			names.AttrNamePrefix: listschema.StringAttribute{
+				Optional:    true,
+				Description: "Prefix of the log groups' names.",
+			},
+			names.AttrTags: listschema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Tags that the log groups must have.",
+			},
+		},
+	}
+}
+
+func (l *groupListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var data groupListResourceModel
+	if diags := request.Config.Get(ctx, &data); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	conn := l.Meta().LogsClient(ctx)
+
+	input := cloudwatchlogs.DescribeLogGroupsInput{}
+	if v := data.NamePrefix.ValueString(); v != "" {
+		input.LogGroupNamePrefix = aws.String(v)
+	}
+	// DescribeLogGroups does not return log group tags.
+	tags := tftags.New(ctx, fwflex.ExpandFrameworkStringValueMap(ctx, data.Tags))
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		err := describeLogGroupsPages(ctx, conn, &input, func(page *cloudwatchlogs.DescribeLogGroupsOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, v := range page.LogGroups {
+				name := aws.ToString(v.LogGroupName)
+
+				if len(tags) > 0 {
+					groupTags, err := listTags(ctx, conn, TrimLogGroupARNWildcardSuffix(aws.ToString(v.Arn)))
+
+					if err != nil {
+						var result list.ListResult
+						result.Diagnostics.AddError(fmt.Sprintf("listing tags for CloudWatch Logs Log Group (%s)", name), err.Error())
+						yield(result)
+						return false
+					}
+
+					if !groupTags.ContainsAll(tags) {
+						continue
+					}
+				}
+
+				d := l.ResourceData()
+				d.SetId(name)
+				d.Set(names.AttrName, name)
+
+				result := request.NewListResult(ctx)
+				result.DisplayName = name
+
+				l.SetResult(ctx, request.IncludeResource, d, &result)
+				if result.Diagnostics.HasError() {
+					yield(result)
+					return false
+				}
+
+				// Deleted while being listed.
+				if d.Id() == "" {
+					continue
+				}
+
+				if !yield(result) {
+					return false
+				}
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			var result list.ListResult
+			result.Diagnostics.AddError("listing CloudWatch Logs Log Groups", err.Error())
+			yield(result)
+		}
+	}
+}
+
+type groupListResourceModel struct {
+	NamePrefix types.String `tfsdk:"name_prefix"`
+	Tags       types.Map    `tfsdk:"tags"`
+}
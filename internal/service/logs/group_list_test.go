@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLogsGroupList_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LogsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLogGroupDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupListConfig_basic(rName),
+			},
+			{
+				Query:  true,
+				Config: testAccGroupListQuery_basic(rName),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("aws_cloudwatch_log_group.test", 1),
+					querycheck.ExpectResourceDisplayName("aws_cloudwatch_log_group.test", queryfilter.ByDisplayName(knownvalue.StringExact(rName)), knownvalue.StringExact(rName)),
+					querycheck.ExpectLength("aws_cloudwatch_log_group.tags", 1),
+					querycheck.ExpectLength("aws_cloudwatch_log_group.no_match", 0),
+				},
+			},
+		},
+	})
+}
+
+func testAccGroupListConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
+
+func testAccGroupListQuery_basic(rName string) string {
+	return fmt.Sprintf(`
+provider "aws" {}
+
+list "aws_cloudwatch_log_group" "test" {
+  provider = aws
+
+  config {
+    name_prefix = %[1]q
+  }
+}
+
+list "aws_cloudwatch_log_group" "tags" {
+  provider = aws
+
+  config {
+    name_prefix = %[1]q
+
+    tags = {
+      Name = %[1]q
+    }
+  }
+}
+
+list "aws_cloudwatch_log_group" "no_match" {
+  provider = aws
+
+  config {
+    name_prefix = %[1]q
+
+    tags = {
+      Name = "%[1]s-no-match"
+    }
+  }
+}
+`, rName)
+}
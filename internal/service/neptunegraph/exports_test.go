@@ -0,0 +1,15 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package neptunegraph
+
+// Exports for use in tests only.
+var (
+	ResourceGraph                = newGraphResource
+	ResourceGraphSnapshot        = newGraphSnapshotResource
+	ResourcePrivateGraphEndpoint = newPrivateGraphEndpointResource
+
+	FindGraphByID                        = findGraphByID
+	FindGraphSnapshotByID                = findGraphSnapshotByID
+	FindPrivateGraphEndpointByTwoPartKey = findPrivateGraphEndpointByTwoPartKey
+)
@@ -39,6 +39,7 @@ import (
 
 // @FrameworkResource(name="Pipeline")
 // @Tags(identifierAttribute="pipeline_arn")
+// @IdentityAttribute("pipeline_name")
 func newPipelineResource(_ context.Context) (resource.ResourceWithConfigure, error) {
 	r := &pipelineResource{}
 
@@ -26,6 +26,11 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: "pipeline_arn",
 			},
+			Identity: &types.ServicePackageResourceIdentity{
+				Attributes: []string{
+					"pipeline_name",
+				},
+			},
 		},
 	}
 }
@@ -0,0 +1,428 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pricing
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/pricing"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pricing/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// hoursPerMonth is the number of hours in a month used by AWS pricing, i.e. 365 * 24 / 12.
+	hoursPerMonth = 730
+
+	costEstimateCurrency = "USD"
+)
+
+// costQuery is the price of a single product, e.g. an instance-hour, and the quantity of it used per month.
+type costQuery struct {
+	description string
+	serviceCode string
+	filters     map[string]string
+	quantity    float64
+}
+
+// costQueryFunc returns the price queries for a resource type's planned attributes in the specified AWS Region.
+type costQueryFunc func(region string, attributes map[string]string) ([]costQuery, error)
+
+// costQueryFuncs maps supported resource types to their price queries.
+var costQueryFuncs = map[string]costQueryFunc{
+	"aws_db_instance": dbInstanceCostQueries,
+	"aws_ebs_volume":  ebsVolumeCostQueries,
+	"aws_instance":    instanceCostQueries,
+}
+
+func costEstimateResourceTypes() []string {
+	return slices.Sorted(maps.Keys(costQueryFuncs))
+}
+
+type costLineItem struct {
+	Description string
+	MonthlyCost float64
+	Quantity    float64
+	Unit        string
+	UnitPrice   float64
+}
+
+type costEstimate struct {
+	LineItems   []costLineItem
+	MonthlyCost float64
+}
+
+type unitPrice struct {
+	unit  string
+	value float64
+}
+
+// priceCache caches unit prices by query across all cost estimates made by the provider.
+// Prices rarely change and the Pricing API has low request rate quotas.
+type priceCache struct {
+	mu     sync.Mutex
+	prices map[string]unitPrice
+}
+
+func (c *priceCache) get(key string) (unitPrice, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	v, ok := c.prices[key]
+
+	return v, ok
+}
+
+func (c *priceCache) put(key string, v unitPrice) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.prices[key] = v
+}
+
+var prices = &priceCache{
+	prices: make(map[string]unitPrice),
+}
+
+// costEstimator estimates the monthly On-Demand cost of resources.
+type costEstimator struct {
+	client pricing.GetProductsAPIClient
+	cache  *priceCache
+}
+
+func newCostEstimator(client pricing.GetProductsAPIClient) *costEstimator {
+	return &costEstimator{
+		client: client,
+		cache:  prices,
+	}
+}
+
+func (e *costEstimator) estimate(ctx context.Context, resourceType, region string, attributes map[string]string) (*costEstimate, error) {
+	f, ok := costQueryFuncs[resourceType]
+	if !ok {
+		return nil, fmt.Errorf("unsupported resource type (%s)", resourceType)
+	}
+
+	queries, err := f(region, attributes)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", resourceType, err)
+	}
+
+	estimate := &costEstimate{}
+	for _, query := range queries {
+		price, err := e.unitPrice(ctx, query)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s: %w", resourceType, query.description, err)
+		}
+
+		lineItem := costLineItem{
+			Description: query.description,
+			MonthlyCost: price.value * query.quantity,
+			Quantity:    query.quantity,
+			Unit:        price.unit,
+			UnitPrice:   price.value,
+		}
+		estimate.LineItems = append(estimate.LineItems, lineItem)
+		estimate.MonthlyCost += lineItem.MonthlyCost
+	}
+
+	return estimate, nil
+}
+
+func (e *costEstimator) unitPrice(ctx context.Context, query costQuery) (unitPrice, error) {
+	key := query.cacheKey()
+	if v, ok := e.cache.get(key); ok {
+		return v, nil
+	}
+
+	input := pricing.GetProductsInput{
+		MaxResults:  aws.Int32(10),
+		ServiceCode: aws.String(query.serviceCode),
+	}
+	for _, field := range slices.Sorted(maps.Keys(query.filters)) {
+		input.Filters = append(input.Filters, awstypes.Filter{
+			Field: aws.String(field),
+			Type:  awstypes.FilterTypeTermMatch,
+			Value: aws.String(query.filters[field]),
+		})
+	}
+
+	output, err := e.client.GetProducts(ctx, &input)
+
+	if err != nil {
+		return unitPrice{}, fmt.Errorf("reading Pricing Products: %w", err)
+	}
+
+	if len(output.PriceList) == 0 {
+		return unitPrice{}, fmt.Errorf("no %s products match %s", query.serviceCode, query.filterString())
+	}
+
+	// An ambiguous query would make the estimate depend on the order of the results.
+	if n := len(output.PriceList); n > 1 {
+		return unitPrice{}, fmt.Errorf("%d %s products match %s, expected 1", n, query.serviceCode, query.filterString())
+	}
+
+	price, err := onDemandUnitPrice(output.PriceList[0])
+	if err != nil {
+		return unitPrice{}, err
+	}
+
+	e.cache.put(key, price)
+
+	return price, nil
+}
+
+func (q costQuery) filterString() string {
+	var s []string
+	for _, field := range slices.Sorted(maps.Keys(q.filters)) {
+		s = append(s, field+"="+q.filters[field])
+	}
+
+	return strings.Join(s, ",")
+}
+
+func (q costQuery) cacheKey() string {
+	return q.serviceCode + "|" + q.filterString()
+}
+
+// priceListItem is the subset of a Pricing API price list item used to estimate costs.
+type priceListItem struct {
+	Terms struct {
+		OnDemand map[string]struct {
+			PriceDimensions map[string]struct {
+				BeginRange   string            `json:"beginRange"`
+				PricePerUnit map[string]string `json:"pricePerUnit"`
+				Unit         string            `json:"unit"`
+			} `json:"priceDimensions"`
+		} `json:"OnDemand"`
+	} `json:"terms"`
+}
+
+// onDemandUnitPrice returns the first tier On-Demand unit price from a price list item.
+func onDemandUnitPrice(s string) (unitPrice, error) {
+	var item priceListItem
+	if err := json.Unmarshal([]byte(s), &item); err != nil {
+		return unitPrice{}, fmt.Errorf("parsing price list item: %w", err)
+	}
+
+	for _, term := range item.Terms.OnDemand {
+		for _, dimension := range term.PriceDimensions {
+			if dimension.BeginRange != "" && dimension.BeginRange != "0" {
+				continue
+			}
+
+			v, ok := dimension.PricePerUnit[costEstimateCurrency]
+			if !ok {
+				continue
+			}
+
+			value, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				return unitPrice{}, fmt.Errorf("parsing price (%s): %w", v, err)
+			}
+
+			return unitPrice{unit: dimension.Unit, value: value}, nil
+		}
+	}
+
+	return unitPrice{}, fmt.Errorf("no On-Demand %s price found", costEstimateCurrency)
+}
+
+func requiredCostAttribute(attributes map[string]string, name string) (string, error) {
+	if v := attributes[name]; v != "" {
+		return v, nil
+	}
+
+	return "", fmt.Errorf("attribute %q is required", name)
+}
+
+func costAttributeFloat(attributes map[string]string, name string) (float64, bool, error) {
+	v := attributes[name]
+	if v == "" {
+		return 0, false, nil
+	}
+
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		return 0, false, fmt.Errorf("attribute %q: %w", name, err)
+	}
+
+	return f, true, nil
+}
+
+func costAttributeBool(attributes map[string]string, name string) (bool, error) {
+	v := attributes[name]
+	if v == "" {
+		return false, nil
+	}
+
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return false, fmt.Errorf("attribute %q: %w", name, err)
+	}
+
+	return b, nil
+}
+
+func costAttributeMapped(attributes map[string]string, name, defaultValue string, m map[string]string) (string, error) {
+	v := attributes[name]
+	if v == "" {
+		v = defaultValue
+	}
+
+	if v, ok := m[v]; ok {
+		return v, nil
+	}
+
+	return "", fmt.Errorf("attribute %q: unsupported value (%s)", name, v)
+}
+
+func instanceCostQueries(region string, attributes map[string]string) ([]costQuery, error) {
+	instanceType, err := requiredCostAttribute(attributes, names.AttrInstanceType)
+	if err != nil {
+		return nil, err
+	}
+
+	tenancy, err := costAttributeMapped(attributes, "tenancy", "default", map[string]string{
+		"dedicated": "Dedicated",
+		"default":   "Shared",
+		"host":      "Host",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return []costQuery{
+		{
+			description: fmt.Sprintf("EC2 instance (%s)", instanceType),
+			serviceCode: "AmazonEC2",
+			filters: map[string]string{
+				"capacitystatus":  "Used",
+				"instanceType":    instanceType,
+				"licenseModel":    "No License required",
+				"marketoption":    "OnDemand",
+				"operatingSystem": "Linux",
+				"preInstalledSw":  "NA",
+				"regionCode":      region,
+				"tenancy":         tenancy,
+			},
+			quantity: hoursPerMonth,
+		},
+	}, nil
+}
+
+func ebsVolumeCostQueries(region string, attributes map[string]string) ([]costQuery, error) {
+	size, ok, err := costAttributeFloat(attributes, names.AttrSize)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, fmt.Errorf("attribute %q is required", names.AttrSize)
+	}
+
+	volumeType := attributes[names.AttrType]
+	if volumeType == "" {
+		volumeType = "gp3"
+	}
+
+	return []costQuery{
+		{
+			description: fmt.Sprintf("EBS volume storage (%s)", volumeType),
+			serviceCode: "AmazonEC2",
+			filters: map[string]string{
+				"productFamily": "Storage",
+				"regionCode":    region,
+				"volumeApiName": volumeType,
+			},
+			quantity: size,
+		},
+	}, nil
+}
+
+func dbInstanceCostQueries(region string, attributes map[string]string) ([]costQuery, error) {
+	instanceClass, err := requiredCostAttribute(attributes, "instance_class")
+	if err != nil {
+		return nil, err
+	}
+
+	engine, err := requiredCostAttribute(attributes, names.AttrEngine)
+	if err != nil {
+		return nil, err
+	}
+	engine, err = costAttributeMapped(attributes, names.AttrEngine, engine, map[string]string{
+		"mariadb":  "MariaDB",
+		"mysql":    "MySQL",
+		"postgres": "PostgreSQL",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	multiAZ, err := costAttributeBool(attributes, "multi_az")
+	if err != nil {
+		return nil, err
+	}
+	deploymentOption := "Single-AZ"
+	if multiAZ {
+		deploymentOption = "Multi-AZ"
+	}
+
+	queries := []costQuery{
+		{
+			description: fmt.Sprintf("RDS DB instance (%s)", instanceClass),
+			serviceCode: "AmazonRDS",
+			filters: map[string]string{
+				"databaseEngine":   engine,
+				"deploymentOption": deploymentOption,
+				"instanceType":     instanceClass,
+				"regionCode":       region,
+			},
+			quantity: hoursPerMonth,
+		},
+	}
+
+	allocatedStorage, ok, err := costAttributeFloat(attributes, names.AttrAllocatedStorage)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		storageType := attributes[names.AttrStorageType]
+		if storageType == "" {
+			storageType = "gp2"
+		}
+		volumeType, err := costAttributeMapped(attributes, names.AttrStorageType, storageType, map[string]string{
+			"gp2":      "General Purpose",
+			"gp3":      "General Purpose-GP3",
+			"io1":      "Provisioned IOPS",
+			"io2":      "Provisioned IOPS-IO2",
+			"standard": "Magnetic",
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		queries = append(queries, costQuery{
+			description: fmt.Sprintf("RDS DB instance storage (%s)", storageType),
+			serviceCode: "AmazonRDS",
+			filters: map[string]string{
+				"databaseEngine":   engine,
+				"deploymentOption": deploymentOption,
+				"productFamily":    "Database Storage",
+				"regionCode":       region,
+				"volumeType":       volumeType,
+			},
+			quantity: allocatedStorage,
+		})
+	}
+
+	return queries, nil
+}
@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pricing
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Cost Estimate")
+func newCostEstimateDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &costEstimateDataSource{}, nil
+}
+
+type costEstimateDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*costEstimateDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_pricing_cost_estimate"
+}
+
+func (d *costEstimateDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrAttributes: schema.MapAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"currency": schema.StringAttribute{
+				Computed: true,
+			},
+			"line_items": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[costLineItemModel](ctx),
+				Computed:   true,
+			},
+			"monthly_cost": schema.Float64Attribute{
+				Computed: true,
+			},
+			// The Region whose prices are used, not the Region of the Pricing API endpoint.
+			names.AttrRegion: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			names.AttrResourceType: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(costEstimateResourceTypes()...),
+				},
+			},
+		},
+	}
+}
+
+func (d *costEstimateDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data costEstimateDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().PricingClient(ctx)
+
+	var attributes map[string]string
+	response.Diagnostics.Append(data.Attributes.ElementsAs(ctx, &attributes, false)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	region := data.Region.ValueString()
+	if region == "" {
+		region = d.Meta().Region(ctx)
+	}
+	resourceType := data.ResourceType.ValueString()
+
+	estimate, err := newCostEstimator(conn).estimate(ctx, resourceType, region, attributes)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("estimating cost of %s in %s", resourceType, region), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, estimate, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.Currency = fwflex.StringValueToFramework(ctx, costEstimateCurrency)
+	data.Region = fwflex.StringValueToFramework(ctx, region)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type costEstimateDataSourceModel struct {
+	Attributes   types.Map                                          `tfsdk:"attributes"`
+	Currency     types.String                                       `tfsdk:"currency"`
+	LineItems    fwtypes.ListNestedObjectValueOf[costLineItemModel] `tfsdk:"line_items"`
+	MonthlyCost  types.Float64                                      `tfsdk:"monthly_cost"`
+	Region       types.String                                       `tfsdk:"region"`
+	ResourceType types.String                                       `tfsdk:"resource_type"`
+}
+
+type costLineItemModel struct {
+	Description types.String  `tfsdk:"description"`
+	MonthlyCost types.Float64 `tfsdk:"monthly_cost"`
+	Quantity    types.Float64 `tfsdk:"quantity"`
+	Unit        types.String  `tfsdk:"unit"`
+	UnitPrice   types.Float64 `tfsdk:"unit_price"`
+}
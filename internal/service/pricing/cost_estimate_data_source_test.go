@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pricing_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccPricingCostEstimateDataSource_instance(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_pricing_cost_estimate.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckRegion(t, endpoints.UsEast1RegionID, endpoints.ApSouth1RegionID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.PricingServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCostEstimateDataSourceConfig_instance,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "currency", "USD"),
+					resource.TestCheckResourceAttr(dataSourceName, "line_items.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "line_items.0.quantity", "730"),
+					resource.TestCheckResourceAttr(dataSourceName, "line_items.0.unit", "Hrs"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "monthly_cost"),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrRegion, "eu-west-1"), //lintignore:AWSAT003
+				),
+			},
+		},
+	})
+}
+
+func TestAccPricingCostEstimateDataSource_dbInstance(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_pricing_cost_estimate.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckRegion(t, endpoints.UsEast1RegionID, endpoints.ApSouth1RegionID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.PricingServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCostEstimateDataSourceConfig_dbInstance,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "line_items.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "line_items.1.quantity", "20"),
+					resource.TestCheckResourceAttr(dataSourceName, "line_items.1.unit", "GB-Mo"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "monthly_cost"),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrRegion, acctest.Region()),
+				),
+			},
+		},
+	})
+}
+
+const testAccCostEstimateDataSourceConfig_instance = `
+data "aws_pricing_cost_estimate" "test" {
+  resource_type = "aws_instance"
+  region        = "eu-west-1"
+
+  attributes = {
+    instance_type = "t3.micro"
+  }
+}
+`
+
+const testAccCostEstimateDataSourceConfig_dbInstance = `
+data "aws_pricing_cost_estimate" "test" {
+  resource_type = "aws_db_instance"
+
+  attributes = {
+    engine            = "postgres"
+    instance_class    = "db.t3.micro"
+    allocated_storage = 20
+    multi_az          = false
+  }
+}
+`
@@ -0,0 +1,253 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pricing
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/pricing"
+	"github.com/google/go-cmp/cmp"
+)
+
+// mockGetProductsClient returns a price list item for each filter value mapped to a price.
+type mockGetProductsClient struct {
+	prices     map[string]string // Filter value to USD price.
+	units      map[string]string // Filter value to unit.
+	duplicates map[string]bool   // Filter values that match two products.
+	calls      int
+}
+
+func (c *mockGetProductsClient) GetProducts(_ context.Context, input *pricing.GetProductsInput, _ ...func(*pricing.Options)) (*pricing.GetProductsOutput, error) {
+	c.calls++
+
+	output := &pricing.GetProductsOutput{}
+	for _, filter := range input.Filters {
+		value := aws.ToString(filter.Value)
+		if price, ok := c.prices[value]; ok {
+			item := fmt.Sprintf(`{"terms":{"OnDemand":{"SKU.JRTCKXETXF":{"priceDimensions":{"SKU.JRTCKXETXF.6YS6EN2CT7":{"beginRange":"0","unit":%q,"pricePerUnit":{"USD":%q}}}}}}}`, c.units[value], price)
+			output.PriceList = append(output.PriceList, item)
+			if c.duplicates[value] {
+				output.PriceList = append(output.PriceList, item)
+			}
+			break
+		}
+	}
+
+	return output, nil
+}
+
+func TestCostEstimator(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		resourceType  string
+		attributes    map[string]string
+		expected      *costEstimate
+		expectedError bool
+	}{
+		"instance": {
+			resourceType: "aws_instance",
+			attributes: map[string]string{
+				"instance_type": "t3.micro",
+			},
+			expected: &costEstimate{
+				LineItems: []costLineItem{
+					{
+						Description: "EC2 instance (t3.micro)",
+						MonthlyCost: 7.592,
+						Quantity:    hoursPerMonth,
+						Unit:        "Hrs",
+						UnitPrice:   0.0104,
+					},
+				},
+				MonthlyCost: 7.592,
+			},
+		},
+		"instance missing instance_type": {
+			resourceType:  "aws_instance",
+			attributes:    map[string]string{},
+			expectedError: true,
+		},
+		"instance invalid tenancy": {
+			resourceType: "aws_instance",
+			attributes: map[string]string{
+				"instance_type": "t3.micro",
+				"tenancy":       "shared",
+			},
+			expectedError: true,
+		},
+		"EBS volume": {
+			resourceType: "aws_ebs_volume",
+			attributes: map[string]string{
+				"size": "100",
+			},
+			expected: &costEstimate{
+				LineItems: []costLineItem{
+					{
+						Description: "EBS volume storage (gp3)",
+						MonthlyCost: 8,
+						Quantity:    100,
+						Unit:        "GB-Mo",
+						UnitPrice:   0.08,
+					},
+				},
+				MonthlyCost: 8,
+			},
+		},
+		"EBS volume invalid size": {
+			resourceType: "aws_ebs_volume",
+			attributes: map[string]string{
+				"size": "large",
+			},
+			expectedError: true,
+		},
+		"DB instance": {
+			resourceType: "aws_db_instance",
+			attributes: map[string]string{
+				"allocated_storage": "20",
+				"engine":            "postgres",
+				"instance_class":    "db.t3.micro",
+			},
+			expected: &costEstimate{
+				LineItems: []costLineItem{
+					{
+						Description: "RDS DB instance (db.t3.micro)",
+						MonthlyCost: 13.14,
+						Quantity:    hoursPerMonth,
+						Unit:        "Hrs",
+						UnitPrice:   0.018,
+					},
+					{
+						Description: "RDS DB instance storage (gp2)",
+						MonthlyCost: 2.3,
+						Quantity:    20,
+						Unit:        "GB-Mo",
+						UnitPrice:   0.115,
+					},
+				},
+				MonthlyCost: 15.44,
+			},
+		},
+		"DB instance unsupported engine": {
+			resourceType: "aws_db_instance",
+			attributes: map[string]string{
+				"engine":         "oracle-ee",
+				"instance_class": "db.t3.micro",
+			},
+			expectedError: true,
+		},
+		"no matching product": {
+			resourceType: "aws_instance",
+			attributes: map[string]string{
+				"instance_type": "x99.huge",
+			},
+			expectedError: true,
+		},
+		"multiple matching products": {
+			resourceType: "aws_instance",
+			attributes: map[string]string{
+				"instance_type": "c5.large",
+			},
+			expectedError: true,
+		},
+		"unsupported resource type": {
+			resourceType:  "aws_s3_bucket",
+			attributes:    map[string]string{},
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			client := &mockGetProductsClient{
+				prices: map[string]string{
+					"c5.large":        "0.0850000000",
+					"db.t3.micro":     "0.0180000000",
+					"General Purpose": "0.1150000000",
+					"gp3":             "0.0800000000",
+					"t3.micro":        "0.0104000000",
+				},
+				units: map[string]string{
+					"db.t3.micro":     "Hrs",
+					"General Purpose": "GB-Mo",
+					"gp3":             "GB-Mo",
+					"t3.micro":        "Hrs",
+				},
+				duplicates: map[string]bool{
+					"c5.large": true,
+				},
+			}
+			estimator := &costEstimator{
+				client: client,
+				cache:  &priceCache{prices: make(map[string]unitPrice)},
+			}
+
+			got, err := estimator.estimate(ctx, testCase.resourceType, "us-east-1", testCase.attributes) //lintignore:AWSAT003
+
+			if got, want := err != nil, testCase.expectedError; got != want {
+				t.Fatalf("estimate err %t, want %t: %v", got, want, err)
+			}
+			if err != nil {
+				return
+			}
+
+			if diff := cmp.Diff(got, testCase.expected, floatComparer); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestCostEstimator_cache(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := &mockGetProductsClient{
+		prices: map[string]string{
+			"m5.large": "0.096",
+		},
+		units: map[string]string{
+			"m5.large": "Hrs",
+		},
+	}
+	estimator := &costEstimator{
+		client: client,
+		cache:  &priceCache{prices: make(map[string]unitPrice)},
+	}
+	attributes := map[string]string{
+		"instance_type": "m5.large",
+	}
+
+	for range 3 {
+		if _, err := estimator.estimate(ctx, "aws_instance", "eu-west-1", attributes); err != nil { //lintignore:AWSAT003
+			t.Fatalf("estimate: %s", err)
+		}
+	}
+
+	if got, want := client.calls, 1; got != want {
+		t.Errorf("GetProducts calls = %d, want %d", got, want)
+	}
+
+	if _, err := estimator.estimate(ctx, "aws_instance", "eu-west-2", attributes); err != nil { //lintignore:AWSAT003
+		t.Fatalf("estimate: %s", err)
+	}
+
+	if got, want := client.calls, 2; got != want {
+		t.Errorf("GetProducts calls = %d, want %d", got, want)
+	}
+}
+
+var floatComparer = cmp.Comparer(func(x, y float64) bool {
+	const epsilon = 1e-9
+
+	d := x - y
+	return d < epsilon && d > -epsilon
+})
@@ -0,0 +1,711 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package qbusiness
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/qbusiness"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/qbusiness/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Plugin")
+// @Tags(identifierAttribute="arn")
+func newPluginResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &pluginResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type pluginResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (*pluginResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_qbusiness_plugin"
+}
+
+func (r *pluginResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	credentialsBlock := func() schema.NestedBlockObject {
+		return schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				names.AttrRoleARN: schema.StringAttribute{
+					CustomType: fwtypes.ARNType,
+					Required:   true,
+				},
+				"secret_arn": schema.StringAttribute{
+					CustomType: fwtypes.ARNType,
+					Required:   true,
+				},
+			},
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrApplicationID: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDisplayName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"plugin_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"server_url": schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrState: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.PluginState](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			names.AttrType: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.PluginType](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			// If neither credentials block is configured the plugin uses no authentication.
+			"auth_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[pluginAuthConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"basic_auth_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[pluginCredentialsModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("basic_auth_configuration"),
+									path.MatchRelative().AtParent().AtName("oauth2_client_credential_configuration"),
+								),
+							},
+							NestedObject: credentialsBlock(),
+						},
+						"oauth2_client_credential_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[pluginCredentialsModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: credentialsBlock(),
+						},
+					},
+				},
+			},
+			"custom_plugin_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[customPluginConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"api_schema_type": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.APISchemaType](),
+							Required:   true,
+						},
+						names.AttrDescription: schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 200),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"api_schema": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[pluginAPISchemaModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"payload": schema.StringAttribute{
+										Optional: true,
+										Validators: []validator.String{
+											stringvalidator.ExactlyOneOf(
+												path.MatchRelative().AtParent().AtName("payload"),
+												path.MatchRelative().AtParent().AtName("s3"),
+											),
+										},
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"s3": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[pluginS3Model](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												names.AttrBucket: schema.StringAttribute{
+													Required: true,
+												},
+												names.AttrKey: schema.StringAttribute{
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *pluginResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data pluginResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	input := &qbusiness.CreatePluginInput{
+		ApplicationId: fwflex.StringFromFramework(ctx, data.ApplicationID),
+		ClientToken:   aws.String(sdkid.UniqueId()),
+		DisplayName:   fwflex.StringFromFramework(ctx, data.DisplayName),
+		ServerUrl:     fwflex.StringFromFramework(ctx, data.ServerURL),
+		Tags:          getTagsIn(ctx),
+		Type:          data.Type.ValueEnum(),
+	}
+
+	// AutoFlEx doesn't yet handle union types.
+	var diags diag.Diagnostics
+	input.AuthConfiguration, input.CustomPluginConfiguration, diags = data.expandConfigurations(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreatePlugin(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Amazon Q Business Plugin (%s)", data.DisplayName.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.PluginID = fwflex.StringToFramework(ctx, output.PluginId)
+	data.setID()
+
+	plugin, err := waitPluginCreated(ctx, conn, data.ApplicationID.ValueString(), data.PluginID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Amazon Q Business Plugin (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// The plugin state can only be set on update.
+	if state := data.State.ValueEnum(); !data.State.IsUnknown() && state != plugin.State {
+		input := &qbusiness.UpdatePluginInput{
+			ApplicationId: fwflex.StringFromFramework(ctx, data.ApplicationID),
+			PluginId:      fwflex.StringFromFramework(ctx, data.PluginID),
+			State:         state,
+		}
+
+		_, err := conn.UpdatePlugin(ctx, input)
+
+		if err != nil {
+			response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+			response.Diagnostics.AddError(fmt.Sprintf("updating Amazon Q Business Plugin (%s) state", data.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		if plugin, err = waitPluginUpdated(ctx, conn, data.ApplicationID.ValueString(), data.PluginID.ValueString(), r.CreateTimeout(ctx, data.Timeouts)); err != nil {
+			response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for Amazon Q Business Plugin (%s) update", data.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	data.PluginARN = fwflex.StringToFramework(ctx, plugin.PluginArn)
+	data.State = fwtypes.StringEnumValue(plugin.State)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *pluginResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data pluginResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	output, err := findPluginByTwoPartKey(ctx, conn, data.ApplicationID.ValueString(), data.PluginID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Amazon Q Business Plugin (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.DisplayName = fwflex.StringToFramework(ctx, output.DisplayName)
+	data.PluginARN = fwflex.StringToFramework(ctx, output.PluginArn)
+	data.ServerURL = fwflex.StringToFramework(ctx, output.ServerUrl)
+	data.State = fwtypes.StringEnumValue(output.State)
+	data.Type = fwtypes.StringEnumValue(output.Type)
+
+	// AutoFlEx doesn't yet handle union types.
+	data.AuthConfiguration = flattenPluginAuthConfiguration(ctx, output.AuthConfiguration)
+	data.CustomPluginConfiguration = flattenCustomPluginConfiguration(ctx, output.CustomPluginConfiguration)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *pluginResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new pluginResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	if !new.AuthConfiguration.Equal(old.AuthConfiguration) ||
+		!new.CustomPluginConfiguration.Equal(old.CustomPluginConfiguration) ||
+		!new.DisplayName.Equal(old.DisplayName) ||
+		!new.ServerURL.Equal(old.ServerURL) ||
+		!new.State.Equal(old.State) {
+		input := &qbusiness.UpdatePluginInput{
+			ApplicationId: fwflex.StringFromFramework(ctx, new.ApplicationID),
+			DisplayName:   fwflex.StringFromFramework(ctx, new.DisplayName),
+			PluginId:      fwflex.StringFromFramework(ctx, new.PluginID),
+			ServerUrl:     fwflex.StringFromFramework(ctx, new.ServerURL),
+			State:         new.State.ValueEnum(),
+		}
+
+		// AutoFlEx doesn't yet handle union types.
+		var diags diag.Diagnostics
+		input.AuthConfiguration, input.CustomPluginConfiguration, diags = new.expandConfigurations(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdatePlugin(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Amazon Q Business Plugin (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		if _, err := waitPluginUpdated(ctx, conn, new.ApplicationID.ValueString(), new.PluginID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for Amazon Q Business Plugin (%s) update", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *pluginResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data pluginResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	_, err := conn.DeletePlugin(ctx, &qbusiness.DeletePluginInput{
+		ApplicationId: fwflex.StringFromFramework(ctx, data.ApplicationID),
+		PluginId:      fwflex.StringFromFramework(ctx, data.PluginID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Amazon Q Business Plugin (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitPluginDeleted(ctx, conn, data.ApplicationID.ValueString(), data.PluginID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Amazon Q Business Plugin (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *pluginResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findPluginByTwoPartKey(ctx context.Context, conn *qbusiness.Client, applicationID, pluginID string) (*qbusiness.GetPluginOutput, error) {
+	input := &qbusiness.GetPluginInput{
+		ApplicationId: aws.String(applicationID),
+		PluginId:      aws.String(pluginID),
+	}
+
+	output, err := conn.GetPlugin(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusPluginBuild(ctx context.Context, conn *qbusiness.Client, applicationID, pluginID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findPluginByTwoPartKey(ctx, conn, applicationID, pluginID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.BuildStatus), nil
+	}
+}
+
+func waitPluginCreated(ctx context.Context, conn *qbusiness.Client, applicationID, pluginID string, timeout time.Duration) (*qbusiness.GetPluginOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.PluginBuildStatusCreateInProgress),
+		Target:  enum.Slice(awstypes.PluginBuildStatusReady),
+		Refresh: statusPluginBuild(ctx, conn, applicationID, pluginID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*qbusiness.GetPluginOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPluginUpdated(ctx context.Context, conn *qbusiness.Client, applicationID, pluginID string, timeout time.Duration) (*qbusiness.GetPluginOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.PluginBuildStatusUpdateInProgress),
+		Target:  enum.Slice(awstypes.PluginBuildStatusReady),
+		Refresh: statusPluginBuild(ctx, conn, applicationID, pluginID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*qbusiness.GetPluginOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPluginDeleted(ctx context.Context, conn *qbusiness.Client, applicationID, pluginID string, timeout time.Duration) (*qbusiness.GetPluginOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.PluginBuildStatusReady, awstypes.PluginBuildStatusDeleteInProgress),
+		Target:  []string{},
+		Refresh: statusPluginBuild(ctx, conn, applicationID, pluginID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*qbusiness.GetPluginOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+type pluginResourceModel struct {
+	ApplicationID             types.String                                                    `tfsdk:"application_id"`
+	AuthConfiguration         fwtypes.ListNestedObjectValueOf[pluginAuthConfigurationModel]   `tfsdk:"auth_configuration"`
+	CustomPluginConfiguration fwtypes.ListNestedObjectValueOf[customPluginConfigurationModel] `tfsdk:"custom_plugin_configuration"`
+	DisplayName               types.String                                                    `tfsdk:"display_name"`
+	ID                        types.String                                                    `tfsdk:"id"`
+	PluginARN                 types.String                                                    `tfsdk:"arn"`
+	PluginID                  types.String                                                    `tfsdk:"plugin_id"`
+	ServerURL                 types.String                                                    `tfsdk:"server_url"`
+	State                     fwtypes.StringEnum[awstypes.PluginState]                        `tfsdk:"state"`
+	Tags                      types.Map                                                       `tfsdk:"tags"`
+	TagsAll                   types.Map                                                       `tfsdk:"tags_all"`
+	Timeouts                  timeouts.Value                                                  `tfsdk:"timeouts"`
+	Type                      fwtypes.StringEnum[awstypes.PluginType]                         `tfsdk:"type"`
+}
+
+const (
+	pluginResourceIDPartCount = 2
+)
+
+func (m *pluginResourceModel) InitFromID() error {
+	id := m.ID.ValueString()
+	parts, err := flex.ExpandResourceId(id, pluginResourceIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	m.ApplicationID = types.StringValue(parts[0])
+	m.PluginID = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (m *pluginResourceModel) setID() {
+	m.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{m.ApplicationID.ValueString(), m.PluginID.ValueString()}, pluginResourceIDPartCount, false)))
+}
+
+func (m *pluginResourceModel) expandConfigurations(ctx context.Context) (awstypes.PluginAuthConfiguration, *awstypes.CustomPluginConfiguration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	authConfigurationData, d := m.AuthConfiguration.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, nil, diags
+	}
+
+	customPluginConfigurationData, d := m.CustomPluginConfiguration.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, nil, diags
+	}
+
+	return expandPluginAuthConfiguration(ctx, authConfigurationData), expandCustomPluginConfiguration(ctx, customPluginConfigurationData), diags
+}
+
+type pluginAuthConfigurationModel struct {
+	BasicAuthConfiguration              fwtypes.ListNestedObjectValueOf[pluginCredentialsModel] `tfsdk:"basic_auth_configuration"`
+	OAuth2ClientCredentialConfiguration fwtypes.ListNestedObjectValueOf[pluginCredentialsModel] `tfsdk:"oauth2_client_credential_configuration"`
+}
+
+type pluginCredentialsModel struct {
+	RoleARN   fwtypes.ARN `tfsdk:"role_arn"`
+	SecretARN fwtypes.ARN `tfsdk:"secret_arn"`
+}
+
+type customPluginConfigurationModel struct {
+	APISchema     fwtypes.ListNestedObjectValueOf[pluginAPISchemaModel] `tfsdk:"api_schema"`
+	APISchemaType fwtypes.StringEnum[awstypes.APISchemaType]            `tfsdk:"api_schema_type"`
+	Description   types.String                                          `tfsdk:"description"`
+}
+
+type pluginAPISchemaModel struct {
+	Payload types.String                                   `tfsdk:"payload"`
+	S3      fwtypes.ListNestedObjectValueOf[pluginS3Model] `tfsdk:"s3"`
+}
+
+type pluginS3Model struct {
+	Bucket types.String `tfsdk:"bucket"`
+	Key    types.String `tfsdk:"key"`
+}
+
+func expandPluginAuthConfiguration(ctx context.Context, authConfigurationData *pluginAuthConfigurationModel) awstypes.PluginAuthConfiguration {
+	if authConfigurationData != nil {
+		if !authConfigurationData.BasicAuthConfiguration.IsNull() {
+			credentialsData := fwdiag.Must(authConfigurationData.BasicAuthConfiguration.ToPtr(ctx))
+
+			return &awstypes.PluginAuthConfigurationMemberBasicAuthConfiguration{
+				Value: awstypes.BasicAuthConfiguration{
+					RoleArn:   fwflex.StringFromFramework(ctx, credentialsData.RoleARN),
+					SecretArn: fwflex.StringFromFramework(ctx, credentialsData.SecretARN),
+				},
+			}
+		}
+
+		if !authConfigurationData.OAuth2ClientCredentialConfiguration.IsNull() {
+			credentialsData := fwdiag.Must(authConfigurationData.OAuth2ClientCredentialConfiguration.ToPtr(ctx))
+
+			return &awstypes.PluginAuthConfigurationMemberOAuth2ClientCredentialConfiguration{
+				Value: awstypes.OAuth2ClientCredentialConfiguration{
+					RoleArn:   fwflex.StringFromFramework(ctx, credentialsData.RoleARN),
+					SecretArn: fwflex.StringFromFramework(ctx, credentialsData.SecretARN),
+				},
+			}
+		}
+	}
+
+	return &awstypes.PluginAuthConfigurationMemberNoAuthConfiguration{}
+}
+
+func flattenPluginAuthConfiguration(ctx context.Context, apiObject awstypes.PluginAuthConfiguration) fwtypes.ListNestedObjectValueOf[pluginAuthConfigurationModel] {
+	authConfigurationData := pluginAuthConfigurationModel{
+		BasicAuthConfiguration:              fwtypes.NewListNestedObjectValueOfNull[pluginCredentialsModel](ctx),
+		OAuth2ClientCredentialConfiguration: fwtypes.NewListNestedObjectValueOfNull[pluginCredentialsModel](ctx),
+	}
+
+	switch v := apiObject.(type) {
+	case *awstypes.PluginAuthConfigurationMemberBasicAuthConfiguration:
+		authConfigurationData.BasicAuthConfiguration = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &pluginCredentialsModel{
+			RoleARN:   fwtypes.ARNValue(aws.ToString(v.Value.RoleArn)),
+			SecretARN: fwtypes.ARNValue(aws.ToString(v.Value.SecretArn)),
+		})
+
+	case *awstypes.PluginAuthConfigurationMemberOAuth2ClientCredentialConfiguration:
+		authConfigurationData.OAuth2ClientCredentialConfiguration = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &pluginCredentialsModel{
+			RoleARN:   fwtypes.ARNValue(aws.ToString(v.Value.RoleArn)),
+			SecretARN: fwtypes.ARNValue(aws.ToString(v.Value.SecretArn)),
+		})
+
+	default:
+		return fwtypes.NewListNestedObjectValueOfNull[pluginAuthConfigurationModel](ctx)
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &authConfigurationData)
+}
+
+func expandCustomPluginConfiguration(ctx context.Context, customPluginConfigurationData *customPluginConfigurationModel) *awstypes.CustomPluginConfiguration {
+	if customPluginConfigurationData == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.CustomPluginConfiguration{
+		ApiSchemaType: customPluginConfigurationData.APISchemaType.ValueEnum(),
+		Description:   fwflex.StringFromFramework(ctx, customPluginConfigurationData.Description),
+	}
+
+	if apiSchemaData := fwdiag.Must(customPluginConfigurationData.APISchema.ToPtr(ctx)); apiSchemaData != nil {
+		if !apiSchemaData.Payload.IsNull() {
+			apiObject.ApiSchema = &awstypes.APISchemaMemberPayload{
+				Value: apiSchemaData.Payload.ValueString(),
+			}
+		} else if s3Data := fwdiag.Must(apiSchemaData.S3.ToPtr(ctx)); s3Data != nil {
+			apiObject.ApiSchema = &awstypes.APISchemaMemberS3{
+				Value: awstypes.S3{
+					Bucket: fwflex.StringFromFramework(ctx, s3Data.Bucket),
+					Key:    fwflex.StringFromFramework(ctx, s3Data.Key),
+				},
+			}
+		}
+	}
+
+	return apiObject
+}
+
+func flattenCustomPluginConfiguration(ctx context.Context, apiObject *awstypes.CustomPluginConfiguration) fwtypes.ListNestedObjectValueOf[customPluginConfigurationModel] {
+	if apiObject == nil {
+		return fwtypes.NewListNestedObjectValueOfNull[customPluginConfigurationModel](ctx)
+	}
+
+	apiSchemaData := pluginAPISchemaModel{
+		Payload: types.StringNull(),
+		S3:      fwtypes.NewListNestedObjectValueOfNull[pluginS3Model](ctx),
+	}
+
+	switch v := apiObject.ApiSchema.(type) {
+	case *awstypes.APISchemaMemberPayload:
+		apiSchemaData.Payload = fwflex.StringValueToFramework(ctx, v.Value)
+
+	case *awstypes.APISchemaMemberS3:
+		apiSchemaData.S3 = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &pluginS3Model{
+			Bucket: fwflex.StringToFramework(ctx, v.Value.Bucket),
+			Key:    fwflex.StringToFramework(ctx, v.Value.Key),
+		})
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &customPluginConfigurationModel{
+		APISchema:     fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &apiSchemaData),
+		APISchemaType: fwtypes.StringEnumValue(apiObject.ApiSchemaType),
+		Description:   fwflex.StringToFramework(ctx, apiObject.Description),
+	})
+}
@@ -149,7 +149,10 @@ func (h *instanceHandler) modifyTarget(ctx context.Context, identifier string, d
 		DBInstanceIdentifier: aws.String(identifier),
 	}
 
-	needsModify := dbInstancePopulateModify(modifyInput, d)
+	needsModify, err := dbInstancePopulateModify(modifyInput, d)
+	if err != nil {
+		return fmt.Errorf("updating Green environment: %s", err)
+	}
 
 	if needsModify {
 		log.Printf("[DEBUG] %s: Updating Green environment", operation)
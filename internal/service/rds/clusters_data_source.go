@@ -74,7 +74,7 @@ func dataSourceClustersRead(ctx context.Context, d *schema.ResourceData, meta in
 		return create.AppendDiagError(diags, names.RDS, create.ErrActionReading, DSNameClusters, "", err)
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).Region(ctx))
 	d.Set("cluster_arns", clusterArns)
 	d.Set("cluster_identifiers", clusterIdentifiers)
 
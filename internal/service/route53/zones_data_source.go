@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// @FrameworkDataSource("aws_route53_zones", name="Zones")
+func newZonesDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &zonesDataSource{}, nil
+}
+
+type zonesDataSource struct {
+	framework.PluralDataSource[zoneSummaryModel]
+}
+
+func (d *zonesDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_route53_zones"
+}
+
+func (d *zonesDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	s, diags := d.PluralSchema(ctx, map[string]schema.Attribute{
+		"private_zone": schema.BoolAttribute{
+			Optional: true,
+		},
+	})
+	response.Diagnostics.Append(diags...)
+	response.Schema = s
+}
+
+func (d *zonesDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data zonesDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().Route53Client(ctx)
+
+	input := route53.ListHostedZonesInput{}
+
+	response.Diagnostics.Append(d.ReadObjects(ctx, &data.PluralDataSourceModel, "Route 53 Hosted Zones", func(ctx context.Context, fn func(framework.PluralDataSourceObject) bool) error {
+		return listHostedZonesPages(ctx, conn, &input, func(page *route53.ListHostedZonesOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, v := range page.HostedZones {
+				summary := zoneSummary{
+					Name:                   normalizeZoneName(v.Name),
+					ResourceRecordSetCount: v.ResourceRecordSetCount,
+					ZoneID:                 cleanZoneID(aws.ToString(v.Id)),
+				}
+				if v := v.Config; v != nil {
+					summary.Comment = v.Comment
+					summary.PrivateZone = v.PrivateZone
+				}
+				if v := v.LinkedService; v != nil {
+					summary.LinkedServicePrincipal = v.ServicePrincipal
+				}
+
+				if !data.PrivateZone.IsNull() && summary.PrivateZone != data.PrivateZone.ValueBool() {
+					continue
+				}
+
+				zoneID := summary.ZoneID
+
+				if !fn(framework.PluralDataSourceObject{
+					ARN: arn.ARN{
+						Partition: d.Meta().Partition,
+						Service:   "route53",
+						Resource:  "hostedzone/" + zoneID,
+					}.String(),
+					ID:     zoneID,
+					Names:  []string{summary.Name},
+					Source: summary,
+					// ListHostedZones does not return hosted zone tags.
+					Tags: func(ctx context.Context) (tftags.KeyValueTags, error) {
+						return listTags(ctx, conn, zoneID, string(awstypes.TagResourceTypeHostedzone))
+					},
+				}) {
+					return false
+				}
+			}
+
+			return !lastPage
+		})
+	})...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// zoneSummary is a hosted zone with its ID and name normalized and its configuration flattened.
+type zoneSummary struct {
+	Comment                *string
+	LinkedServicePrincipal *string
+	Name                   string
+	PrivateZone            bool
+	ResourceRecordSetCount *int64
+	ZoneID                 string
+}
+
+type zonesDataSourceModel struct {
+	framework.PluralDataSourceModel[zoneSummaryModel]
+	PrivateZone types.Bool `tfsdk:"private_zone"`
+}
+
+type zoneSummaryModel struct {
+	Comment                types.String `tfsdk:"comment"`
+	LinkedServicePrincipal types.String `tfsdk:"linked_service_principal"`
+	Name                   types.String `tfsdk:"name"`
+	PrivateZone            types.Bool   `tfsdk:"private_zone"`
+	ResourceRecordSetCount types.Int64  `tfsdk:"resource_record_set_count"`
+	ZoneID                 types.String `tfsdk:"zone_id"`
+}
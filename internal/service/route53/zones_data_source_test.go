@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRoute53ZonesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	domainName := acctest.RandomDomainName()
+	dataSourceName := "data.aws_route53_zones.test"
+	resourceName := "aws_route53_zone.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckZoneDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZonesDataSourceConfig_basic(domainName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "arns.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arns.0", resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "ids.0", resourceName, "zone_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "summaries.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "summaries.0.comment", "Managed by Terraform"),
+					resource.TestCheckResourceAttr(dataSourceName, "summaries.0.name", domainName),
+					resource.TestCheckResourceAttr(dataSourceName, "summaries.0.private_zone", acctest.CtFalse),
+				),
+			},
+		},
+	})
+}
+
+func testAccZonesDataSourceConfig_basic(domainName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = %[1]q
+}
+
+data "aws_route53_zones" "test" {
+  name_regex   = %[2]q
+  private_zone = false
+
+  depends_on = [aws_route53_zone.test]
+}
+`, domainName, "^"+regexp.QuoteMeta(domainName)+"$")
+}
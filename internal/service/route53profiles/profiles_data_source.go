@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53profiles
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/route53profiles"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53profiles/types"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Profiles")
+func newProfilesDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &profilesDataSource{}, nil
+}
+
+const (
+	DSNameProfiles = "Profiles Data Source"
+)
+
+type profilesDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *profilesDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_route53profiles_profiles"
+}
+
+func (d *profilesDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"profiles": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[profileSummaryModel](ctx),
+				Computed:   true,
+				ElementType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						names.AttrARN:  types.StringType,
+						names.AttrID:   types.StringType,
+						names.AttrName: types.StringType,
+						"share_status": fwtypes.StringEnumType[awstypes.ShareStatus](),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *profilesDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data profilesDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().Route53ProfilesClient(ctx)
+
+	output, err := findProfiles(ctx, conn, &route53profiles.ListProfilesInput{})
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Route53Profiles, create.ErrActionReading, DSNameProfiles, "", err),
+			err.Error(),
+		)
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data.Profiles)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(d.Meta().Region(ctx))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type profilesDataSourceModel struct {
+	ID       types.String                                         `tfsdk:"id"`
+	Profiles fwtypes.ListNestedObjectValueOf[profileSummaryModel] `tfsdk:"profiles"`
+}
+
+type profileSummaryModel struct {
+	ARN         types.String                             `tfsdk:"arn"`
+	ID          types.String                             `tfsdk:"id"`
+	Name        types.String                             `tfsdk:"name"`
+	ShareStatus fwtypes.StringEnum[awstypes.ShareStatus] `tfsdk:"share_status"`
+}
+
+func findProfiles(ctx context.Context, conn *route53profiles.Client, input *route53profiles.ListProfilesInput) ([]awstypes.ProfileSummary, error) {
+	var output []awstypes.ProfileSummary
+
+	pages := route53profiles.NewListProfilesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.ProfileSummaries...)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53profiles
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53profiles"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53profiles/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func RegisterSweepers() {
+	sweep.Register("aws_route53profiles_association", sweepAssociations)
+
+	sweep.Register("aws_route53profiles_profile", sweepProfiles,
+		"aws_route53profiles_association",
+		"aws_route53profiles_resource_association",
+	)
+
+	sweep.Register("aws_route53profiles_resource_association", sweepResourceAssociations)
+}
+
+func sweepAssociations(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.Route53ProfilesClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	pages := route53profiles.NewListProfileAssociationsPaginator(conn, &route53profiles.ListProfileAssociationsInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if awsv2.SkipSweepError(err) {
+			tflog.Warn(ctx, "Skipping sweeper", map[string]any{
+				"error": err.Error(),
+			})
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.ProfileAssociations {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newAssociationResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.Id)),
+				framework.NewAttribute("profile_id", aws.ToString(v.ProfileId)),
+				framework.NewAttribute(names.AttrResourceID, aws.ToString(v.ResourceId))))
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepProfiles(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.Route53ProfilesClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	pages := route53profiles.NewListProfilesPaginator(conn, &route53profiles.ListProfilesInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if awsv2.SkipSweepError(err) {
+			tflog.Warn(ctx, "Skipping sweeper", map[string]any{
+				"error": err.Error(),
+			})
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.ProfileSummaries {
+			// Profiles shared from another account can't be deleted.
+			if v.ShareStatus == awstypes.ShareStatusSharedWithMe {
+				continue
+			}
+
+			sweepResources = append(sweepResources, framework.NewSweepResource(newProfileResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.Id))))
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepResourceAssociations(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.Route53ProfilesClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	profiles, err := findProfiles(ctx, conn, &route53profiles.ListProfilesInput{})
+
+	if awsv2.SkipSweepError(err) {
+		tflog.Warn(ctx, "Skipping sweeper", map[string]any{
+			"error": err.Error(),
+		})
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, profile := range profiles {
+		if profile.ShareStatus == awstypes.ShareStatusSharedWithMe {
+			continue
+		}
+
+		pages := route53profiles.NewListProfileResourceAssociationsPaginator(conn, &route53profiles.ListProfileResourceAssociationsInput{
+			ProfileId: profile.Id,
+		})
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, v := range page.ProfileResourceAssociations {
+				sweepResources = append(sweepResources, framework.NewSweepResource(newResourceAssociationResource, client,
+					framework.NewAttribute(names.AttrID, aws.ToString(v.Id)),
+					framework.NewAttribute("profile_id", aws.ToString(v.ProfileId)),
+					framework.NewAttribute(names.AttrResourceARN, aws.ToString(v.ResourceArn))))
+			}
+		}
+	}
+
+	return sweepResources, nil
+}
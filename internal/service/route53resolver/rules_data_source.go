@@ -96,7 +96,7 @@ func dataSourceRulesRead(ctx context.Context, d *schema.ResourceData, meta inter
 		return sdkdiag.AppendErrorf(diags, "listing Route53 Resolver Rules: %s", err)
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).Region(ctx))
 
 	d.Set("resolver_rule_ids", aws.StringValueSlice(ruleIDs))
 
@@ -0,0 +1,138 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_s3_bucket", name="Bucket")
+func newBucketListResource(context.Context) (list.ListResourceWithConfigure, error) {
+	return &bucketListResource{}, nil
+}
+
+type bucketListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+func (l *bucketListResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_s3_bucket"
+}
+
+func (l *bucketListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			names.AttrNamePrefix: listschema.StringAttribute{
+				Optional:    true,
+				Description: "Prefix of the buckets' names.",
+			},
+			names.AttrTags: listschema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Tags that the buckets must have.",
+			},
+		},
+	}
+}
+
+func (l *bucketListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var data bucketListResourceModel
+	if diags := request.Config.Get(ctx, &data); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	conn := l.Meta().S3Client(ctx)
+	region := l.Meta().Region(ctx)
+
+	namePrefix := data.NamePrefix.ValueString()
+	// ListBuckets does not return bucket tags.
+	tags := tftags.New(ctx, fwflex.ExpandFrameworkStringValueMap(ctx, data.Tags))
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		// Without BucketRegion, ListBuckets returns all general purpose buckets owned by the account, regardless of Region.
+		input := s3.ListBucketsInput{
+			BucketRegion: aws.String(region),
+		}
+		if namePrefix != "" {
+			input.Prefix = aws.String(namePrefix)
+		}
+
+		pages := s3.NewListBucketsPaginator(conn, &input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				var result list.ListResult
+				result.Diagnostics.AddError("listing S3 Buckets", err.Error())
+				yield(result)
+				return
+			}
+
+			for _, v := range page.Buckets {
+				name := aws.ToString(v.Name)
+
+				if len(tags) > 0 {
+					bucketTags, err := bucketListTags(ctx, conn, name)
+
+					// Deleted while being listed.
+					if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
+						continue
+					}
+
+					if err != nil {
+						var result list.ListResult
+						result.Diagnostics.AddError(fmt.Sprintf("listing tags for S3 Bucket (%s)", name), err.Error())
+						yield(result)
+						return
+					}
+
+					if !bucketTags.ContainsAll(tags) {
+						continue
+					}
+				}
+
+				d := l.ResourceData()
+				d.SetId(name)
+				d.Set(names.AttrBucket, name)
+
+				result := request.NewListResult(ctx)
+				result.DisplayName = name
+
+				l.SetResult(ctx, request.IncludeResource, d, &result)
+				if result.Diagnostics.HasError() {
+					yield(result)
+					return
+				}
+
+				// Deleted while being listed.
+				if d.Id() == "" {
+					continue
+				}
+
+				if !yield(result) {
+					return
+				}
+			}
+		}
+	}
+}
+
+type bucketListResourceModel struct {
+	NamePrefix types.String `tfsdk:"name_prefix"`
+	Tags       types.Map    `tfsdk:"tags"`
+}
@@ -0,0 +1,352 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+var ruleFilterCmpOpts = []cmp.Option{
+	cmpopts.IgnoreUnexported(
+		types.LifecycleRuleAndOperator{},
+		types.LifecycleRuleFilter{},
+		types.ReplicationRuleAndOperator{},
+		types.ReplicationRuleFilter{},
+		types.Tag{},
+	),
+}
+
+func lifecycleRuleFilterConfig(m map[string]interface{}) []interface{} {
+	tfMap := map[string]interface{}{
+		"and":                      []interface{}{},
+		"object_size_greater_than": "",
+		"object_size_less_than":    "",
+		names.AttrPrefix:           "",
+		"tag":                      []interface{}{},
+	}
+	for k, v := range m {
+		tfMap[k] = v
+	}
+
+	return []interface{}{tfMap}
+}
+
+func TestExpandLifecycleRuleFilter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		input    []interface{}
+		expected *types.LifecycleRuleFilter
+	}{
+		"no filter": {
+			input: []interface{}{},
+		},
+		"empty filter": {
+			input: []interface{}{nil},
+		},
+		"empty prefix": {
+			input: lifecycleRuleFilterConfig(nil),
+			expected: &types.LifecycleRuleFilter{
+				Prefix: aws.String(""),
+			},
+		},
+		"prefix": {
+			input: lifecycleRuleFilterConfig(map[string]interface{}{
+				names.AttrPrefix: "logs/",
+			}),
+			expected: &types.LifecycleRuleFilter{
+				Prefix: aws.String("logs/"),
+			},
+		},
+		"object size greater than": {
+			input: lifecycleRuleFilterConfig(map[string]interface{}{
+				"object_size_greater_than": "100",
+			}),
+			expected: &types.LifecycleRuleFilter{
+				ObjectSizeGreaterThan: aws.Int64(100),
+			},
+		},
+		"object size less than": {
+			input: lifecycleRuleFilterConfig(map[string]interface{}{
+				"object_size_less_than": "500",
+			}),
+			expected: &types.LifecycleRuleFilter{
+				ObjectSizeLessThan: aws.Int64(500),
+			},
+		},
+		"tag": {
+			input: lifecycleRuleFilterConfig(map[string]interface{}{
+				"tag": []interface{}{map[string]interface{}{
+					names.AttrKey:   "k1",
+					names.AttrValue: "v1",
+				}},
+			}),
+			expected: &types.LifecycleRuleFilter{
+				Tag: &types.Tag{Key: aws.String("k1"), Value: aws.String("v1")},
+			},
+		},
+		"and": {
+			input: lifecycleRuleFilterConfig(map[string]interface{}{
+				"and": []interface{}{map[string]interface{}{
+					"object_size_greater_than": 100,
+					"object_size_less_than":    500,
+					names.AttrPrefix:           "logs/",
+					names.AttrTags:             map[string]interface{}{"k1": "v1"},
+				}},
+			}),
+			expected: &types.LifecycleRuleFilter{
+				And: &types.LifecycleRuleAndOperator{
+					ObjectSizeGreaterThan: aws.Int64(100),
+					ObjectSizeLessThan:    aws.Int64(500),
+					Prefix:                aws.String("logs/"),
+					Tags:                  []types.Tag{{Key: aws.String("k1"), Value: aws.String("v1")}},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := expandLifecycleRuleFilter(ctx, testCase.input)
+
+			if diff := cmp.Diff(got, testCase.expected, ruleFilterCmpOpts...); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenLifecycleRuleFilter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		input    *types.LifecycleRuleFilter
+		expected []interface{}
+	}{
+		"nil": {},
+		"empty": {
+			input: &types.LifecycleRuleFilter{},
+		},
+		"empty prefix": {
+			input: &types.LifecycleRuleFilter{
+				Prefix: aws.String(""),
+			},
+			expected: []interface{}{map[string]interface{}{
+				names.AttrPrefix: "",
+			}},
+		},
+		"object size greater than": {
+			input: &types.LifecycleRuleFilter{
+				ObjectSizeGreaterThan: aws.Int64(100),
+			},
+			expected: []interface{}{map[string]interface{}{
+				"object_size_greater_than": "100",
+			}},
+		},
+		"object size less than": {
+			input: &types.LifecycleRuleFilter{
+				ObjectSizeLessThan: aws.Int64(500),
+			},
+			expected: []interface{}{map[string]interface{}{
+				"object_size_less_than": "500",
+			}},
+		},
+		"tag": {
+			input: &types.LifecycleRuleFilter{
+				Tag: &types.Tag{Key: aws.String("k1"), Value: aws.String("v1")},
+			},
+			expected: []interface{}{map[string]interface{}{
+				"tag": []interface{}{map[string]interface{}{
+					names.AttrKey:   "k1",
+					names.AttrValue: "v1",
+				}},
+			}},
+		},
+		"and": {
+			input: &types.LifecycleRuleFilter{
+				And: &types.LifecycleRuleAndOperator{
+					ObjectSizeGreaterThan: aws.Int64(100),
+					Prefix:                aws.String("logs/"),
+					Tags:                  []types.Tag{{Key: aws.String("k1"), Value: aws.String("v1")}},
+				},
+			},
+			expected: []interface{}{map[string]interface{}{
+				"and": []interface{}{map[string]interface{}{
+					"object_size_greater_than": aws.Int64(100),
+					"object_size_less_than":    (*int64)(nil),
+					names.AttrPrefix:           "logs/",
+					names.AttrTags:             map[string]string{"k1": "v1"},
+				}},
+			}},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := flattenLifecycleRuleFilter(ctx, testCase.input)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandReplicationRuleFilter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		input    []interface{}
+		expected *types.ReplicationRuleFilter
+	}{
+		"empty filter": {
+			input: []interface{}{nil},
+			expected: &types.ReplicationRuleFilter{
+				Prefix: aws.String(""),
+			},
+		},
+		"prefix": {
+			input: []interface{}{map[string]interface{}{
+				"and":            []interface{}{},
+				names.AttrPrefix: "logs/",
+				"tag":            []interface{}{},
+			}},
+			expected: &types.ReplicationRuleFilter{
+				Prefix: aws.String("logs/"),
+			},
+		},
+		"tag": {
+			input: []interface{}{map[string]interface{}{
+				"and":            []interface{}{},
+				names.AttrPrefix: "",
+				"tag": []interface{}{map[string]interface{}{
+					names.AttrKey:   "k1",
+					names.AttrValue: "v1",
+				}},
+			}},
+			expected: &types.ReplicationRuleFilter{
+				Tag: &types.Tag{Key: aws.String("k1"), Value: aws.String("v1")},
+			},
+		},
+		"and": {
+			input: []interface{}{map[string]interface{}{
+				"and": []interface{}{map[string]interface{}{
+					names.AttrPrefix: "logs/",
+					names.AttrTags:   map[string]interface{}{"k1": "v1"},
+				}},
+				names.AttrPrefix: "",
+				"tag":            []interface{}{},
+			}},
+			expected: &types.ReplicationRuleFilter{
+				And: &types.ReplicationRuleAndOperator{
+					Prefix: aws.String("logs/"),
+					Tags:   []types.Tag{{Key: aws.String("k1"), Value: aws.String("v1")}},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := expandReplicationRuleFilter(ctx, testCase.input)
+
+			if diff := cmp.Diff(got, testCase.expected, ruleFilterCmpOpts...); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenReplicationRuleFilter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		input          *types.ReplicationRuleFilter
+		expected       []interface{}
+		expectedBucket []interface{}
+	}{
+		"nil": {
+			expected:       []interface{}{},
+			expectedBucket: []interface{}{},
+		},
+		"empty prefix": {
+			input: &types.ReplicationRuleFilter{
+				Prefix: aws.String(""),
+			},
+			expected: []interface{}{map[string]interface{}{
+				names.AttrPrefix: "",
+			}},
+			expectedBucket: []interface{}{map[string]interface{}{
+				names.AttrPrefix: "",
+			}},
+		},
+		"tag": {
+			input: &types.ReplicationRuleFilter{
+				Tag: &types.Tag{Key: aws.String("k1"), Value: aws.String("v1")},
+			},
+			expected: []interface{}{map[string]interface{}{
+				"tag": []interface{}{map[string]interface{}{
+					names.AttrKey:   "k1",
+					names.AttrValue: "v1",
+				}},
+			}},
+			expectedBucket: []interface{}{map[string]interface{}{
+				names.AttrTags: map[string]string{"k1": "v1"},
+			}},
+		},
+		"and": {
+			input: &types.ReplicationRuleFilter{
+				And: &types.ReplicationRuleAndOperator{
+					Prefix: aws.String("logs/"),
+					Tags:   []types.Tag{{Key: aws.String("k1"), Value: aws.String("v1")}},
+				},
+			},
+			expected: []interface{}{map[string]interface{}{
+				"and": []interface{}{map[string]interface{}{
+					names.AttrPrefix: "logs/",
+					names.AttrTags:   map[string]string{"k1": "v1"},
+				}},
+			}},
+			expectedBucket: []interface{}{map[string]interface{}{
+				names.AttrPrefix: "logs/",
+				names.AttrTags:   map[string]string{"k1": "v1"},
+			}},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if diff := cmp.Diff(flattenReplicationRuleFilter(ctx, testCase.input), testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(flattenBucketReplicationRuleFilter(ctx, testCase.input), testCase.expectedBucket); diff != "" {
+				t.Errorf("unexpected bucket diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,752 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/fileset"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/sync"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	homedir "github.com/mitchellh/go-homedir"
+)
+
+// @FrameworkResource("aws_s3_directory_sync", name="Directory Sync")
+func newDirectorySyncResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &directorySyncResource{}
+
+	return r, nil
+}
+
+type directorySyncResource struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *directorySyncResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_s3_directory_sync"
+}
+
+func (r *directorySyncResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrBucket: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"concurrency": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(10),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 100),
+				},
+			},
+			"content_types": schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"delete_orphans": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"excludes": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"files": schema.MapNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"cache_control": schema.StringAttribute{
+							Computed: true,
+						},
+						names.AttrContentType: schema.StringAttribute{
+							Computed: true,
+						},
+						"etag": schema.StringAttribute{
+							Computed: true,
+						},
+						names.AttrKey: schema.StringAttribute{
+							Computed: true,
+						},
+						"source_hash": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"includes": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"key_prefix": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(""),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_dir": schema.StringAttribute{
+				Required: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"cache_control": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[cacheControlRuleModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"pattern": schema.StringAttribute{
+							Required: true,
+						},
+						names.AttrValue: schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *directorySyncResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data directorySyncResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3Client(ctx)
+
+	id := data.id()
+	files, diags := data.plannedFiles(ctx, nil)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := syncDirectory(ctx, conn, &data, files, nil); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating S3 Directory Sync (%s)", id), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = types.StringValue(id)
+	response.Diagnostics.Append(data.setFiles(ctx, files)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *directorySyncResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data directorySyncResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3Client(ctx)
+
+	etags, err := findObjectETagsByPrefix(ctx, conn, data.Bucket.ValueString(), data.keyPrefix())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Directory Sync (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	files, diags := data.files(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Objects that have been deleted or changed outside of Terraform are uploaded again.
+	maps.DeleteFunc(files, func(_ string, v directorySyncFileModel) bool {
+		etag, ok := etags[v.Key.ValueString()]
+		return !ok || etag != v.ETag.ValueString()
+	})
+
+	response.Diagnostics.Append(data.setFiles(ctx, files)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *directorySyncResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new directorySyncResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3Client(ctx)
+
+	oldFiles, diags := old.files(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	newFiles, diags := new.plannedFiles(ctx, oldFiles)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := syncDirectory(ctx, conn, &new, newFiles, oldFiles); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating S3 Directory Sync (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(new.setFiles(ctx, newFiles)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *directorySyncResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data directorySyncResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3Client(ctx)
+
+	files, diags := data.files(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	keys := tfslices.ApplyToAll(slices.Collect(maps.Values(files)), func(v directorySyncFileModel) string {
+		return v.Key.ValueString()
+	})
+
+	if err := deleteObjectsByKey(ctx, conn, data.Bucket.ValueString(), keys); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting S3 Directory Sync (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+// ValidateConfig requires a key prefix when orphans are to be deleted.
+// Without one, every object in the bucket that doesn't correspond to a file in the directory would be deleted.
+func (r *directorySyncResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var deleteOrphans types.Bool
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("delete_orphans"), &deleteOrphans)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !deleteOrphans.ValueBool() {
+		return
+	}
+
+	var keyPrefix types.String
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("key_prefix"), &keyPrefix)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if keyPrefix.IsUnknown() {
+		return
+	}
+
+	if keyPrefix.ValueString() == "" {
+		response.Diagnostics.AddAttributeError(
+			path.Root("key_prefix"),
+			"Invalid Attribute Configuration",
+			"key_prefix must be set to a non-empty value when delete_orphans is true",
+		)
+	}
+}
+
+// ModifyPlan computes the manifest of files to be synced.
+// Only files whose contents or metadata differ from the current state are planned to be uploaded.
+func (r *directorySyncResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	// Destroy.
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan directorySyncResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	for _, v := range []attr.Value{plan.CacheControl, plan.ContentTypes, plan.Excludes, plan.Includes, plan.KeyPrefix, plan.SourceDir} {
+		if v.IsUnknown() {
+			response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("files"), types.MapUnknown(directorySyncFileObjectType))...)
+			return
+		}
+	}
+
+	var oldFiles map[string]directorySyncFileModel
+	if !request.State.Raw.IsNull() {
+		var state directorySyncResourceModel
+		response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		var diags diag.Diagnostics
+		oldFiles, diags = state.files(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	files, diags := plan.manifest(ctx, oldFiles)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(plan.setFiles(ctx, files)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("files"), plan.Files)...)
+}
+
+// syncDirectory uploads the new files that don't yet have an ETag and deletes the old files that are no longer present.
+// If `delete_orphans` is set, all other objects with the key prefix are also deleted.
+func syncDirectory(ctx context.Context, conn *s3.Client, data *directorySyncResourceModel, new, old map[string]directorySyncFileModel) error {
+	bucket := data.Bucket.ValueString()
+	dir, err := data.sourceDir()
+	if err != nil {
+		return err
+	}
+
+	var (
+		errs      []error
+		etags     = make(map[string]string)
+		mutex     sync.Mutex
+		semaphore = tfsync.NewSemaphore(int(data.Concurrency.ValueInt64()))
+		wg        sync.WaitGroup
+	)
+	for name, v := range new {
+		if !v.ETag.IsUnknown() {
+			continue
+		}
+
+		if err := semaphore.Acquire(ctx); err != nil {
+			mutex.Lock()
+			errs = append(errs, err)
+			mutex.Unlock()
+			break
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer semaphore.Notify()
+
+			etag, err := uploadDirectorySyncFile(ctx, conn, bucket, filepath.Join(dir, filepath.FromSlash(name)), v)
+
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			if err != nil {
+				errs = append(errs, err)
+				return
+			}
+
+			etags[name] = etag
+		}()
+	}
+	wg.Wait()
+
+	if err := errors.Join(errs...); err != nil {
+		return err
+	}
+
+	for name, etag := range etags {
+		v := new[name]
+		v.ETag = types.StringValue(etag)
+		new[name] = v
+	}
+
+	var toDelete []string
+	if data.DeleteOrphans.ValueBool() {
+		keyPrefix := data.keyPrefix()
+		etags, err := findObjectETagsByPrefix(ctx, conn, bucket, keyPrefix)
+
+		if err != nil {
+			return err
+		}
+
+		toDelete = orphanedObjectKeys(keyPrefix, slices.Collect(maps.Keys(etags)), directorySyncFileKeys(new))
+	} else {
+		toDelete = orphanedObjectKeys("", directorySyncFileKeys(old), directorySyncFileKeys(new))
+	}
+
+	return deleteObjectsByKey(ctx, conn, bucket, toDelete)
+}
+
+// orphanedObjectKeys returns the keys with the specified prefix that aren't current.
+func orphanedObjectKeys(prefix string, keys, current []string) []string {
+	var orphans []string
+	for _, key := range keys {
+		if key == "" || !strings.HasPrefix(key, prefix) || slices.Contains(current, key) {
+			continue
+		}
+		orphans = append(orphans, key)
+	}
+	slices.Sort(orphans)
+
+	return orphans
+}
+
+func directorySyncFileKeys(files map[string]directorySyncFileModel) []string {
+	return tfslices.ApplyToAll(slices.Collect(maps.Values(files)), func(v directorySyncFileModel) string {
+		return v.Key.ValueString()
+	})
+}
+
+// directorySyncKeyPrefix returns the key prefix with a trailing "/" so that object keys and listings never extend to sibling prefixes.
+func directorySyncKeyPrefix(v string) string {
+	if v != "" && !strings.HasSuffix(v, "/") {
+		v += "/"
+	}
+
+	return v
+}
+
+func uploadDirectorySyncFile(ctx context.Context, conn *s3.Client, bucket, filename string, file directorySyncFileModel) (string, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	key := file.Key.ValueString()
+	input := &s3.PutObjectInput{
+		Body:        f,
+		Bucket:      aws.String(bucket),
+		ContentType: fwflex.StringFromFramework(ctx, file.ContentType),
+		Key:         aws.String(key),
+	}
+	if v := file.CacheControl.ValueString(); v != "" {
+		input.CacheControl = aws.String(v)
+	}
+
+	uploader := manager.NewUploader(conn)
+
+	output, err := uploader.Upload(ctx, input)
+
+	if err != nil {
+		return "", fmt.Errorf("uploading S3 Object (%s) to Bucket (%s): %w", key, bucket, err)
+	}
+
+	return normalizeETag(aws.ToString(output.ETag)), nil
+}
+
+// findObjectETagsByPrefix returns the ETags of all objects with the specified key prefix, keyed by object key.
+func findObjectETagsByPrefix(ctx context.Context, conn *s3.Client, bucket, prefix string) (map[string]string, error) {
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+	}
+	if prefix != "" {
+		input.Prefix = aws.String(prefix)
+	}
+
+	etags := make(map[string]string)
+
+	pages := s3.NewListObjectsV2Paginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("listing S3 Bucket (%s) objects: %w", bucket, err)
+		}
+
+		for _, v := range page.Contents {
+			etags[aws.ToString(v.Key)] = normalizeETag(aws.ToString(v.ETag))
+		}
+	}
+
+	return etags, nil
+}
+
+// deleteObjectsByKey deletes the specified objects in batches.
+func deleteObjectsByKey(ctx context.Context, conn *s3.Client, bucket string, keys []string) error {
+	const (
+		batchSize = 1000
+	)
+
+	for chunk := range slices.Chunk(keys, batchSize) {
+		page := &s3.ListObjectsV2Output{
+			Contents: tfslices.ApplyToAll(chunk, func(v string) awstypes.Object {
+				return awstypes.Object{
+					Key: aws.String(v),
+				}
+			}),
+		}
+
+		if _, err := deletePageOfObjects(ctx, conn, bucket, page); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func normalizeETag(etag string) string {
+	return strings.Trim(etag, `"`)
+}
+
+var directorySyncFileObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"cache_control":       types.StringType,
+		names.AttrContentType: types.StringType,
+		"etag":                types.StringType,
+		names.AttrKey:         types.StringType,
+		"source_hash":         types.StringType,
+	},
+}
+
+type directorySyncResourceModel struct {
+	Bucket        types.String                                           `tfsdk:"bucket"`
+	CacheControl  fwtypes.ListNestedObjectValueOf[cacheControlRuleModel] `tfsdk:"cache_control"`
+	Concurrency   types.Int64                                            `tfsdk:"concurrency"`
+	ContentTypes  fwtypes.MapValueOf[types.String]                       `tfsdk:"content_types"`
+	DeleteOrphans types.Bool                                             `tfsdk:"delete_orphans"`
+	Excludes      fwtypes.SetValueOf[types.String]                       `tfsdk:"excludes"`
+	Files         types.Map                                              `tfsdk:"files"`
+	ID            types.String                                           `tfsdk:"id"`
+	Includes      fwtypes.SetValueOf[types.String]                       `tfsdk:"includes"`
+	KeyPrefix     types.String                                           `tfsdk:"key_prefix"`
+	SourceDir     types.String                                           `tfsdk:"source_dir"`
+}
+
+func (data *directorySyncResourceModel) id() string {
+	if v := data.KeyPrefix.ValueString(); v != "" {
+		return data.Bucket.ValueString() + "/" + v
+	}
+
+	return data.Bucket.ValueString()
+}
+
+func (data *directorySyncResourceModel) keyPrefix() string {
+	return directorySyncKeyPrefix(data.KeyPrefix.ValueString())
+}
+
+// sourceDir returns the source directory with any leading "~" expanded.
+func (data *directorySyncResourceModel) sourceDir() (string, error) {
+	return homedir.Expand(data.SourceDir.ValueString())
+}
+
+func (data *directorySyncResourceModel) files(ctx context.Context) (map[string]directorySyncFileModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	files := make(map[string]directorySyncFileModel)
+
+	if data.Files.IsNull() || data.Files.IsUnknown() {
+		return files, diags
+	}
+
+	diags.Append(data.Files.ElementsAs(ctx, &files, false)...)
+
+	return files, diags
+}
+
+// plannedFiles returns the planned files.
+// If the files could not be determined during planning they are determined now.
+func (data *directorySyncResourceModel) plannedFiles(ctx context.Context, old map[string]directorySyncFileModel) (map[string]directorySyncFileModel, diag.Diagnostics) {
+	if data.Files.IsUnknown() {
+		return data.manifest(ctx, old)
+	}
+
+	return data.files(ctx)
+}
+
+func (data *directorySyncResourceModel) setFiles(ctx context.Context, files map[string]directorySyncFileModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.Files, diags = types.MapValueFrom(ctx, directorySyncFileObjectType, files)
+
+	return diags
+}
+
+// manifest returns the files in the source directory along with their object keys, content hashes and metadata.
+// Files that are unchanged from the old files keep their ETags, all others are to be uploaded.
+func (data *directorySyncResourceModel) manifest(ctx context.Context, old map[string]directorySyncFileModel) (map[string]directorySyncFileModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	rules, d := data.CacheControl.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	sourceDir, err := data.sourceDir()
+	if err != nil {
+		diags.AddError(fmt.Sprintf("reading source directory (%s)", data.SourceDir.ValueString()), err.Error())
+		return nil, diags
+	}
+
+	fs, err := fileset.Walk(sourceDir, fwflex.ExpandFrameworkStringValueSet(ctx, data.Includes), fwflex.ExpandFrameworkStringValueSet(ctx, data.Excludes))
+	if err != nil {
+		diags.AddError(fmt.Sprintf("reading source directory (%s)", sourceDir), err.Error())
+		return nil, diags
+	}
+
+	contentTypes := fwflex.ExpandFrameworkStringValueMap(ctx, data.ContentTypes)
+	keyPrefix := data.keyPrefix()
+	files := make(map[string]directorySyncFileModel, len(fs))
+
+	for _, v := range fs {
+		hash, contentType, err := hashAndDetectContentType(v.Path, contentTypes)
+		if err != nil {
+			diags.AddError(fmt.Sprintf("reading source file (%s)", v.Path), err.Error())
+			return nil, diags
+		}
+
+		var cacheControl string
+		for _, rule := range rules {
+			if fileset.Match(rule.Pattern.ValueString(), v.Name) {
+				cacheControl = rule.Value.ValueString()
+				break
+			}
+		}
+
+		file := directorySyncFileModel{
+			CacheControl: types.StringValue(cacheControl),
+			ContentType:  types.StringValue(contentType),
+			ETag:         types.StringUnknown(),
+			Key:          types.StringValue(keyPrefix + v.Name),
+			SourceHash:   types.StringValue(hash),
+		}
+
+		if v, ok := old[v.Name]; ok && v.Key.Equal(file.Key) && v.SourceHash.Equal(file.SourceHash) && v.ContentType.Equal(file.ContentType) && v.CacheControl.Equal(file.CacheControl) {
+			file.ETag = v.ETag
+		}
+
+		files[v.Name] = file
+	}
+
+	return files, diags
+}
+
+// hashAndDetectContentType returns the Base64-encoded SHA256 hash of the specified file's contents and its content type.
+// The content type is determined from the file name extension, or if that is unknown from the file's first 512 bytes.
+func hashAndDetectContentType(filename string, overrides map[string]string) (string, string, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return "", "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	head := make([]byte, 512)
+	n, err := io.ReadFull(f, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return "", "", err
+	}
+	head = head[:n]
+	h.Write(head)
+
+	if _, err := io.Copy(h, f); err != nil {
+		return "", "", err
+	}
+
+	ext := strings.ToLower(filepath.Ext(filename))
+	contentType, ok := overrides[ext]
+	if !ok {
+		contentType = mime.TypeByExtension(ext)
+	}
+	if contentType == "" {
+		contentType = http.DetectContentType(head)
+	}
+
+	return base64.StdEncoding.EncodeToString(h.Sum(nil)), contentType, nil
+}
+
+type cacheControlRuleModel struct {
+	Pattern types.String `tfsdk:"pattern"`
+	Value   types.String `tfsdk:"value"`
+}
+
+type directorySyncFileModel struct {
+	CacheControl types.String `tfsdk:"cache_control"`
+	ContentType  types.String `tfsdk:"content_type"`
+	ETag         types.String `tfsdk:"etag"`
+	Key          types.String `tfsdk:"key"`
+	SourceHash   types.String `tfsdk:"source_hash"`
+}
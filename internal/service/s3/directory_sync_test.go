@@ -0,0 +1,306 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestOrphanedObjectKeys(t *testing.T) {
+	t.Parallel()
+
+	objects := []string{
+		"site-backup/index.html",
+		"site/about.html",
+		"site/index.html",
+		"site/old/page.html",
+		"siteindex.html",
+	}
+
+	testCases := map[string]struct {
+		keyPrefix string
+		current   []string
+		expected  []string
+	}{
+		"prefix without separator": {
+			keyPrefix: "site",
+			current:   []string{"site/index.html"},
+			expected:  []string{"site/about.html", "site/old/page.html"},
+		},
+		"prefix with separator": {
+			keyPrefix: "site/",
+			current:   []string{"site/about.html", "site/index.html"},
+			expected:  []string{"site/old/page.html"},
+		},
+		"all current": {
+			keyPrefix: "site",
+			current:   []string{"site/about.html", "site/index.html", "site/old/page.html"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfs3.OrphanedObjectKeys(tfs3.DirectorySyncKeyPrefix(testCase.keyPrefix), objects, testCase.current)
+
+			if !slices.Equal(got, testCase.expected) {
+				t.Errorf("orphaned keys = %v, want %v", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestAccS3DirectorySync_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory_sync.test"
+	dir := testAccDirectorySyncSourceDir(t, map[string]string{
+		"index.html":   "<html><body>Hello</body></html>",
+		"css/site.css": "body { color: black; }",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectorySyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_basic(rName, dir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectorySyncObjects(ctx, resourceName, "site/css/site.css", "site/index.html"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrBucket, rName),
+					resource.TestCheckResourceAttr(resourceName, "delete_orphans", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "files.%", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "files.index.html.cache_control", "no-cache"),
+					resource.TestCheckResourceAttr(resourceName, "files.index.html.content_type", "text/html; charset=utf-8"),
+					resource.TestCheckResourceAttrSet(resourceName, "files.index.html.etag"),
+					resource.TestCheckResourceAttr(resourceName, "files.index.html.key", "site/index.html"),
+					resource.TestCheckResourceAttr(resourceName, "files.css/site.css.cache_control", "max-age=86400"),
+					resource.TestCheckResourceAttr(resourceName, "files.css/site.css.content_type", "text/css; charset=utf-8"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrID, rName+"/site/"),
+					resource.TestCheckResourceAttr(resourceName, "key_prefix", "site/"),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccDirectorySyncWriteFiles(t, dir, map[string]string{
+						"index.html": "<html><body>Goodbye</body></html>",
+					})
+					if err := os.Remove(filepath.Join(dir, "css", "site.css")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccDirectorySyncConfig_basic(rName, dir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectorySyncObjects(ctx, resourceName, "site/index.html"),
+					resource.TestCheckResourceAttr(resourceName, "files.%", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "files.index.html.key", "site/index.html"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_deleteOrphans(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory_sync.test"
+	dir := testAccDirectorySyncSourceDir(t, map[string]string{
+		"index.html": "<html><body>Hello</body></html>",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectorySyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_deleteOrphans(rName, dir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectorySyncObjects(ctx, resourceName, "site/index.html"),
+					resource.TestCheckResourceAttr(resourceName, "delete_orphans", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "files.%", acctest.Ct1),
+				),
+				// The orphaned object has been deleted.
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_deleteOrphansNoKeyPrefix(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dir := testAccDirectorySyncSourceDir(t, map[string]string{
+		"index.html": "<html><body>Hello</body></html>",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectorySyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDirectorySyncConfig_deleteOrphansNoKeyPrefix(rName, dir),
+				ExpectError: regexache.MustCompile(`key_prefix must be set to a non-empty value when delete_orphans is true`),
+			},
+		},
+	})
+}
+
+func testAccDirectorySyncSourceDir(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	testAccDirectorySyncWriteFiles(t, dir, files)
+
+	return dir
+}
+
+func testAccDirectorySyncWriteFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+
+	for name, contents := range files {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, []byte(contents), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func testAccCheckDirectorySyncDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_s3_directory_sync" {
+				continue
+			}
+
+			etags, err := tfs3.FindObjectETagsByPrefix(ctx, conn, rs.Primary.Attributes[names.AttrBucket], tfs3.DirectorySyncKeyPrefix(rs.Primary.Attributes["key_prefix"]))
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if len(etags) > 0 {
+				return fmt.Errorf("S3 Directory Sync %s objects still exist", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDirectorySyncObjects(ctx context.Context, n string, keys ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		etags, err := tfs3.FindObjectETagsByPrefix(ctx, conn, rs.Primary.Attributes[names.AttrBucket], tfs3.DirectorySyncKeyPrefix(rs.Primary.Attributes["key_prefix"]))
+
+		if err != nil {
+			return err
+		}
+
+		if got, want := slices.Sorted(maps.Keys(etags)), keys; !slices.Equal(got, want) {
+			return fmt.Errorf("S3 Directory Sync %s objects = %q, want %q", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccDirectorySyncConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+`, rName)
+}
+
+func testAccDirectorySyncConfig_basic(rName, dir string) string {
+	return acctest.ConfigCompose(testAccDirectorySyncConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_directory_sync" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  key_prefix = "site/"
+  source_dir = %[1]q
+
+  cache_control {
+    pattern = "**/*.html"
+    value   = "no-cache"
+  }
+
+  cache_control {
+    pattern = "**"
+    value   = "max-age=86400"
+  }
+}
+`, dir))
+}
+
+func testAccDirectorySyncConfig_deleteOrphans(rName, dir string) string {
+	return acctest.ConfigCompose(testAccDirectorySyncConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_object" "orphan" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "site/orphan.txt"
+  content = "orphan"
+
+  lifecycle {
+    ignore_changes = all
+  }
+}
+
+resource "aws_s3_directory_sync" "test" {
+  bucket         = aws_s3_bucket.test.bucket
+  key_prefix     = "site/"
+  source_dir     = %[1]q
+  delete_orphans = true
+
+  depends_on = [aws_s3_object.orphan]
+}
+`, dir))
+}
+
+func testAccDirectorySyncConfig_deleteOrphansNoKeyPrefix(rName, dir string) string {
+	return acctest.ConfigCompose(testAccDirectorySyncConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_directory_sync" "test" {
+  bucket         = aws_s3_bucket.test.bucket
+  source_dir     = %[1]q
+  delete_orphans = true
+}
+`, dir))
+}
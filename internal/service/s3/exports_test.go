@@ -26,12 +26,14 @@ var (
 	ResourceBucketVersioning                        = resourceBucketVersioning
 	ResourceBucketWebsiteConfiguration              = resourceBucketWebsiteConfiguration
 	ResourceDirectoryBucket                         = newDirectoryBucketResource
+	ResourceDirectorySync                           = newDirectorySyncResource
 	ResourceObjectCopy                              = resourceObjectCopy
 
 	BucketUpdateTags                      = bucketUpdateTags
 	BucketRegionalDomainName              = bucketRegionalDomainName
 	BucketWebsiteEndpointAndDomain        = bucketWebsiteEndpointAndDomain
 	DeleteAllObjectVersions               = deleteAllObjectVersions
+	DirectorySyncKeyPrefix                = directorySyncKeyPrefix
 	EmptyBucket                           = emptyBucket
 	FindAnalyticsConfiguration            = findAnalyticsConfiguration
 	FindBucket                            = findBucket
@@ -49,6 +51,7 @@ var (
 	FindLoggingEnabled                    = findLoggingEnabled
 	FindMetricsConfiguration              = findMetricsConfiguration
 	FindObjectByBucketAndKey              = findObjectByBucketAndKey
+	FindObjectETagsByPrefix               = findObjectETagsByPrefix
 	FindObjectLockConfiguration           = findObjectLockConfiguration
 	FindOwnershipControls                 = findOwnershipControls
 	FindPublicAccessBlockConfiguration    = findPublicAccessBlockConfiguration
@@ -58,6 +61,7 @@ var (
 	IsDirectoryBucket                     = isDirectoryBucket
 	ObjectListTags                        = objectListTags
 	ObjectUpdateTags                      = objectUpdateTags
+	OrphanedObjectKeys                    = orphanedObjectKeys
 	SDKv1CompatibleCleanKey               = sdkv1CompatibleCleanKey
 	ValidBucketName                       = validBucketName
 
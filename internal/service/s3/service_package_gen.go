@@ -27,6 +27,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory: newDirectoryBucketResource,
 			Name:    "Directory Bucket",
 		},
+		{
+			Factory: newDirectorySyncResource,
+			Name:    "Directory Sync",
+		},
 	}
 }
 
@@ -78,6 +82,16 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 	}
 }
 
+func (p *servicePackage) SDKListResources(ctx context.Context) []*types.ServicePackageSDKListResource {
+	return []*types.ServicePackageSDKListResource{
+		{
+			Factory:  newBucketListResource,
+			TypeName: "aws_s3_bucket",
+			Name:     "Bucket",
+		},
+	}
+}
+
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
 		{
@@ -88,6 +102,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrBucket,
 				ResourceType:        "Bucket",
 			},
+			Identity: &types.ServicePackageResourceIdentity{
+				Attributes: []string{
+					names.AttrBucket,
+				},
+			},
 		},
 		{
 			Factory:  resourceBucketAccelerateConfiguration,
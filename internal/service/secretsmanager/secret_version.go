@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
 	"github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -20,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -58,7 +60,7 @@ func resourceSecretVersion() *schema.Resource {
 				Optional:      true,
 				ForceNew:      true,
 				Sensitive:     true,
-				ConflictsWith: []string{"secret_string"},
+				ConflictsWith: []string{"secret_string", "secret_string_wo"},
 				ValidateFunc:  verify.ValidBase64String,
 			},
 			"secret_string": {
@@ -66,7 +68,21 @@ func resourceSecretVersion() *schema.Resource {
 				Optional:      true,
 				ForceNew:      true,
 				Sensitive:     true,
-				ConflictsWith: []string{"secret_binary"},
+				ConflictsWith: []string{"secret_binary", "secret_string_wo"},
+			},
+			"secret_string_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				WriteOnly:     true,
+				ConflictsWith: []string{"secret_binary", "secret_string"},
+				RequiredWith:  []string{"secret_string_wo_version"},
+			},
+			"secret_string_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"secret_string_wo"},
 			},
 			"version_id": {
 				Type:     schema.TypeString,
@@ -83,9 +99,13 @@ func resourceSecretVersion() *schema.Resource {
 }
 
 func resourceSecretVersionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SecretsManagerClient(ctx)
 
+	secretStringWO, diags := sdkv2.GetWriteOnlyStringValue(d, cty.GetAttrPath("secret_string_wo"))
+	if diags.HasError() {
+		return diags
+	}
+
 	secretID := d.Get("secret_id").(string)
 	input := &secretsmanager.PutSecretValueInput{
 		ClientRequestToken: aws.String(id.UniqueId()), // Needed because we're handling our own retries
@@ -100,6 +120,8 @@ func resourceSecretVersionCreate(ctx context.Context, d *schema.ResourceData, me
 		}
 	} else if v, ok := d.GetOk("secret_string"); ok {
 		input.SecretString = aws.String(v.(string))
+	} else if secretStringWO != "" {
+		input.SecretString = aws.String(secretStringWO)
 	}
 
 	if v, ok := d.GetOk("version_stages"); ok && v.(*schema.Set).Len() > 0 {
@@ -150,7 +172,10 @@ func resourceSecretVersionRead(ctx context.Context, d *schema.ResourceData, meta
 	d.Set(names.AttrARN, output.ARN)
 	d.Set("secret_binary", itypes.Base64EncodeOnce(output.SecretBinary))
 	d.Set("secret_id", secretID)
-	d.Set("secret_string", output.SecretString)
+	// The value of a write-only secret string is never persisted in state.
+	if _, ok := d.GetOk("secret_string_wo_version"); !ok {
+		d.Set("secret_string", output.SecretString)
+	}
 	d.Set("version_id", output.VersionId)
 	d.Set("version_stages", output.VersionStages)
 
@@ -168,7 +168,7 @@ func testAccCheckDomainIdentityARN(n string, domain string) resource.TestCheckFu
 		expected := arn.ARN{
 			AccountID: awsClient.AccountID,
 			Partition: awsClient.Partition,
-			Region:    awsClient.Region,
+			Region:    awsClient.Region(context.Background()),
 			Resource:  fmt.Sprintf("identity/%s", strings.TrimSuffix(domain, ".")),
 			Service:   "ses",
 		}
@@ -78,7 +78,7 @@ func dataSourceDedicatedIPPoolRead(ctx context.Context, d *schema.ResourceData,
 	poolName := aws.ToString(out.DedicatedIpPool.PoolName)
 	d.SetId(poolName)
 	d.Set("scaling_mode", string(out.DedicatedIpPool.ScalingMode))
-	d.Set(names.AttrARN, poolNameToARN(meta, poolName))
+	d.Set(names.AttrARN, poolNameToARN(ctx, meta, poolName))
 
 	outIP, err := findDedicatedIPPoolIPs(ctx, conn, poolName)
 	if err != nil {
@@ -160,11 +160,11 @@ func resourceEmailIdentityCreate(ctx context.Context, d *schema.ResourceData, me
 	return append(diags, resourceEmailIdentityRead(ctx, d, meta)...)
 }
 
-func emailIdentityNameToARN(meta interface{}, emailIdentityName string) string {
+func emailIdentityNameToARN(ctx context.Context, meta interface{}, emailIdentityName string) string {
 	return arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
 		Service:   "ses",
-		Region:    meta.(*conns.AWSClient).Region,
+		Region:    meta.(*conns.AWSClient).Region(ctx),
 		AccountID: meta.(*conns.AWSClient).AccountID,
 		Resource:  fmt.Sprintf("identity/%s", emailIdentityName),
 	}.String()
@@ -186,7 +186,7 @@ func resourceEmailIdentityRead(ctx context.Context, d *schema.ResourceData, meta
 		return create.AppendDiagError(diags, names.SESV2, create.ErrActionReading, ResNameEmailIdentity, d.Id(), err)
 	}
 
-	arn := emailIdentityNameToARN(meta, d.Id())
+	arn := emailIdentityNameToARN(ctx, meta, d.Id())
 
 	d.Set(names.AttrARN, arn)
 	d.Set("configuration_set_name", out.ConfigurationSetName)
@@ -604,7 +604,7 @@ POLICY
 }
 
 func testAccMaintenanceWindowTaskConfig_basic(rName string) string {
-	return fmt.Sprintf(testAccMaintenanceWindowTaskBaseConfig(rName) + `
+	return acctest.ConfigCompose(testAccMaintenanceWindowTaskBaseConfig(rName), `
 
 resource "aws_ssm_maintenance_window_task" "test" {
   window_id        = aws_ssm_maintenance_window.test.id
@@ -633,7 +633,7 @@ resource "aws_ssm_maintenance_window_task" "test" {
 }
 
 func testAccMaintenanceWindowTaskConfig_noTarget(rName string) string {
-	return fmt.Sprintf(testAccMaintenanceWindowTaskBaseConfig(rName) + `
+	return acctest.ConfigCompose(testAccMaintenanceWindowTaskBaseConfig(rName), `
 
 resource "aws_ssm_maintenance_window_task" "test" {
   window_id        = aws_ssm_maintenance_window.test.id
@@ -727,7 +727,7 @@ EOF
 }
 
 func testAccMaintenanceWindowTaskConfig_basicUpdated(rName string) string {
-	return fmt.Sprintf(testAccMaintenanceWindowTaskBaseConfig(rName) + `
+	return acctest.ConfigCompose(testAccMaintenanceWindowTaskBaseConfig(rName), `
 
 resource "aws_ssm_maintenance_window_task" "test" {
   window_id        = aws_ssm_maintenance_window.test.id
@@ -787,7 +787,7 @@ resource "aws_ssm_maintenance_window_task" "test" {
 }
 
 func testAccMaintenanceWindowTaskConfig_emptyNotifcation(rName string) string {
-	return fmt.Sprintf(testAccMaintenanceWindowTaskBaseConfig(rName) + `
+	return acctest.ConfigCompose(testAccMaintenanceWindowTaskBaseConfig(rName), `
 
 resource "aws_ssm_maintenance_window_task" "test" {
   window_id        = aws_ssm_maintenance_window.test.id
@@ -820,7 +820,7 @@ resource "aws_ssm_maintenance_window_task" "test" {
 }
 
 func testAccMaintenanceWindowTaskConfig_noRole(rName string) string {
-	return fmt.Sprintf(testAccMaintenanceWindowTaskBaseConfig(rName) + `
+	return acctest.ConfigCompose(testAccMaintenanceWindowTaskBaseConfig(rName), `
 resource "aws_ssm_maintenance_window_task" "test" {
   description     = "This resource is for test purpose only"
   max_concurrency = 2
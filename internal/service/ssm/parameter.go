@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/ssm"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -21,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -72,7 +74,7 @@ func resourceParameter() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Computed:     true,
-				ExactlyOneOf: []string{"insecure_value", names.AttrValue},
+				ExactlyOneOf: []string{"insecure_value", names.AttrValue, "value_wo"},
 			},
 			names.AttrKeyID: {
 				Type:     schema.TypeString,
@@ -114,7 +116,20 @@ func resourceParameter() *schema.Resource {
 				Optional:     true,
 				Sensitive:    true,
 				Computed:     true,
-				ExactlyOneOf: []string{"insecure_value", names.AttrValue},
+				ExactlyOneOf: []string{"insecure_value", names.AttrValue, "value_wo"},
+			},
+			"value_wo": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				WriteOnly:    true,
+				ExactlyOneOf: []string{"insecure_value", names.AttrValue, "value_wo"},
+				RequiredWith: []string{"value_wo_version"},
+			},
+			"value_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"value_wo"},
 			},
 			names.AttrVersion: {
 				Type:     schema.TypeInt,
@@ -129,7 +144,7 @@ func resourceParameter() *schema.Resource {
 				return awstypes.ParameterTier(old.(string)) == awstypes.ParameterTierAdvanced && awstypes.ParameterTier(new.(string)) == awstypes.ParameterTierStandard
 			}),
 			customdiff.ComputedIf(names.AttrVersion, func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
-				return diff.HasChange(names.AttrValue)
+				return diff.HasChanges(names.AttrValue, "value_wo_version")
 			}),
 			customdiff.ComputedIf(names.AttrValue, func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
 				return diff.HasChange("insecure_value")
@@ -144,15 +159,22 @@ func resourceParameter() *schema.Resource {
 }
 
 func resourceParameterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SSMClient(ctx)
 
+	valueWO, diags := sdkv2.GetWriteOnlyStringValue(d, cty.GetAttrPath("value_wo"))
+	if diags.HasError() {
+		return diags
+	}
+
 	name := d.Get(names.AttrName).(string)
 	typ := awstypes.ParameterType(d.Get(names.AttrType).(string))
 	value := d.Get(names.AttrValue).(string)
 	if v, ok := d.Get("insecure_value").(string); ok && v != "" {
 		value = v
 	}
+	if valueWO != "" {
+		value = valueWO
+	}
 	input := &ssm.PutParameterInput{
 		AllowedPattern: aws.String(d.Get("allowed_pattern").(string)),
 		Name:           aws.String(name),
@@ -250,7 +272,8 @@ func resourceParameterRead(ctx context.Context, d *schema.ResourceData, meta int
 
 	if _, ok := d.GetOk("insecure_value"); ok && param.Type != awstypes.ParameterTypeSecureString {
 		d.Set("insecure_value", param.Value)
-	} else {
+	} else if _, ok := d.GetOk("value_wo_version"); !ok {
+		// The value of a write-only parameter value is never persisted in state.
 		d.Set(names.AttrValue, param.Value)
 	}
 
@@ -284,11 +307,20 @@ func resourceParameterUpdate(ctx context.Context, d *schema.ResourceData, meta i
 	conn := meta.(*conns.AWSClient).SSMClient(ctx)
 
 	if d.HasChangesExcept("overwrite", names.AttrTags, names.AttrTagsAll) {
+		// The write-only value is never persisted in state so is always read from configuration.
+		valueWO, woDiags := sdkv2.GetWriteOnlyStringValue(d, cty.GetAttrPath("value_wo"))
+		if woDiags.HasError() {
+			return append(diags, woDiags...)
+		}
+
 		typ := awstypes.ParameterType(d.Get(names.AttrType).(string))
 		value := d.Get(names.AttrValue).(string)
 		if v, ok := d.Get("insecure_value").(string); ok && v != "" {
 			value = v
 		}
+		if valueWO != "" {
+			value = valueWO
+		}
 		input := &ssm.PutParameterInput{
 			AllowedPattern: aws.String(d.Get("allowed_pattern").(string)),
 			Name:           aws.String(d.Id()),
@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"context"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @EphemeralResource(name="Parameter")
+func newEphemeralParameter(context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &ephemeralParameter{}, nil
+}
+
+const (
+	ERNameParameter = "Parameter Ephemeral Resource"
+)
+
+type ephemeralParameter struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (e *ephemeralParameter) Metadata(_ context.Context, request ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_ssm_parameter"
+}
+
+func (e *ephemeralParameter) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrType: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ParameterType](),
+				Computed:   true,
+			},
+			names.AttrValue: schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			names.AttrVersion: schema.Int64Attribute{
+				Computed: true,
+			},
+			"with_decryption": schema.BoolAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (e *ephemeralParameter) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data ephemeralParameterModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := e.Meta().SSMClient(ctx)
+
+	withDecryption := true
+	if !data.WithDecryption.IsNull() {
+		withDecryption = data.WithDecryption.ValueBool()
+	}
+
+	// GetParameter accepts either the parameter name or its ARN.
+	arn := data.ARN.ValueString()
+	output, err := findParameterByName(ctx, conn, arn, withDecryption)
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SSM, create.ErrActionReading, ERNameParameter, arn, err),
+			err.Error(),
+		)
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type ephemeralParameterModel struct {
+	ARN            types.String                               `tfsdk:"arn"`
+	Name           types.String                               `tfsdk:"name"`
+	Type           fwtypes.StringEnum[awstypes.ParameterType] `tfsdk:"type"`
+	Value          types.String                               `tfsdk:"value"`
+	Version        types.Int64                                `tfsdk:"version"`
+	WithDecryption types.Bool                                 `tfsdk:"with_decryption"`
+}
@@ -0,0 +1,178 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action(name="Send Command")
+func newSendCommandAction(context.Context) (action.ActionWithConfigure, error) {
+	a := &sendCommandAction{}
+	a.SetDefaultTimeout(30 * time.Minute)
+
+	return a, nil
+}
+
+type sendCommandAction struct {
+	framework.ActionWithConfigure
+	framework.WithActionTimeout
+}
+
+func (a *sendCommandAction) Metadata(_ context.Context, request action.MetadataRequest, response *action.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_ssm_send_command"
+}
+
+func (a *sendCommandAction) Schema(ctx context.Context, request action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrComment: schema.StringAttribute{
+				Optional:    true,
+				Description: "User-specified information about the command.",
+			},
+			"document_name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name or ARN of the SSM document to run.",
+			},
+			"document_version": schema.StringAttribute{
+				Optional:    true,
+				Description: "SSM document version to run.",
+			},
+			"instance_ids": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 50),
+				},
+				Description: "IDs of the managed nodes on which the command should run.",
+			},
+			names.AttrParameters: schema.MapAttribute{
+				ElementType: types.ListType{ElemType: types.StringType},
+				Optional:    true,
+				Description: "Parameters for the SSM document.",
+			},
+			names.AttrTimeout: framework.ActionTimeoutAttribute(),
+		},
+	}
+}
+
+func (a *sendCommandAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	var data sendCommandActionModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().SSMClient(ctx)
+
+	timeout := a.Timeout(data.Timeout)
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	documentName := data.DocumentName.ValueString()
+	input := ssm.SendCommandInput{
+		Comment:         data.Comment.ValueStringPointer(),
+		DocumentName:    aws.String(documentName),
+		DocumentVersion: data.DocumentVersion.ValueStringPointer(),
+		InstanceIds:     fwflex.ExpandFrameworkStringValueList(ctx, data.InstanceIDs),
+	}
+
+	if !data.Parameters.IsNull() {
+		parameters := make(map[string][]string)
+		response.Diagnostics.Append(data.Parameters.ElementsAs(ctx, &parameters, false)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input.Parameters = parameters
+	}
+
+	framework.SendActionProgress(ctx, response, "Sending SSM Command (%s) to %d managed node(s)", documentName, len(input.InstanceIds))
+
+	output, err := conn.SendCommand(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("sending SSM Command (%s)", documentName), err.Error())
+		return
+	}
+
+	commandID := aws.ToString(output.Command.CommandId)
+	framework.SendActionProgress(ctx, response, "Waiting for SSM Command (%s) to complete", commandID)
+
+	err = tfresource.WaitUntil(ctx, timeout, func() (bool, error) {
+		command, err := findCommandByID(ctx, conn, commandID)
+
+		// The command may not yet be visible.
+		if tfresource.NotFound(err) {
+			return false, nil
+		}
+
+		if err != nil {
+			return false, err
+		}
+
+		switch status := command.Status; status {
+		case awstypes.CommandStatusSuccess:
+			return true, nil
+		case awstypes.CommandStatusCancelled, awstypes.CommandStatusFailed, awstypes.CommandStatusTimedOut:
+			return false, fmt.Errorf("SSM Command (%s) %s: %d of %d invocation(s) failed", commandID, status, command.ErrorCount, command.TargetCount)
+		default:
+			framework.SendActionProgress(ctx, response, "SSM Command (%s) status: %s (%d of %d invocation(s) completed)", commandID, status, command.CompletedCount, command.TargetCount)
+			return false, nil
+		}
+	}, tfresource.WaitOpts{
+		PollInterval: 10 * time.Second,
+	})
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for SSM Command (%s) complete", commandID), err.Error())
+		return
+	}
+
+	framework.SendActionProgress(ctx, response, "SSM Command (%s) completed successfully", commandID)
+}
+
+func findCommandByID(ctx context.Context, conn *ssm.Client, id string) (*awstypes.Command, error) {
+	input := &ssm.ListCommandsInput{
+		CommandId: aws.String(id),
+	}
+
+	output, err := conn.ListCommands(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return tfresource.AssertSingleValueResult(output.Commands)
+}
+
+type sendCommandActionModel struct {
+	Comment         types.String                      `tfsdk:"comment"`
+	DocumentName    types.String                      `tfsdk:"document_name"`
+	DocumentVersion types.String                      `tfsdk:"document_version"`
+	InstanceIDs     fwtypes.ListValueOf[types.String] `tfsdk:"instance_ids"`
+	Parameters      types.Map                         `tfsdk:"parameters"`
+	Timeout         types.Int64                       `tfsdk:"timeout"`
+}
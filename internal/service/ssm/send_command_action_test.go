@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm_test
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSSMSendCommandAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstancesDataSourceConfig_filterInstance(rName),
+			},
+			{
+				PreConfig: func() {
+					log.Print("[DEBUG] Test: Sleep to allow SSM Agent to register EC2 instance as a managed node.")
+					time.Sleep(1 * time.Minute)
+				},
+				Config: testAccSendCommandActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSendCommandActionSucceeded(ctx, resourceName, rName),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckSendCommandActionSucceeded verifies that the action ran a command with the specified comment on the instance and that it succeeded.
+func testAccCheckSendCommandActionSucceeded(ctx context.Context, n, comment string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMClient(ctx)
+
+		pages := ssm.NewListCommandsPaginator(conn, &ssm.ListCommandsInput{
+			InstanceId: aws.String(rs.Primary.ID),
+		})
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				return err
+			}
+
+			for _, v := range page.Commands {
+				if aws.ToString(v.Comment) != comment {
+					continue
+				}
+
+				if got, want := v.Status, awstypes.CommandStatusSuccess; got != want {
+					return fmt.Errorf("SSM Command (%s) status = %s, want %s", aws.ToString(v.CommandId), got, want)
+				}
+
+				return nil
+			}
+		}
+
+		return fmt.Errorf("SSM Command with comment %s not found for EC2 Instance (%s)", comment, rs.Primary.ID)
+	}
+}
+
+func testAccSendCommandActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccInstancesDataSourceConfig_filterInstance(rName), fmt.Sprintf(`
+action "aws_ssm_send_command" "test" {
+  config {
+    comment       = %[1]q
+    document_name = "AWS-RunShellScript"
+    instance_ids  = [aws_instance.test.id]
+
+    parameters = {
+      commands = ["echo hello"]
+    }
+  }
+}
+
+resource "terraform_data" "test" {
+  input = aws_instance.test.id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_ssm_send_command.test]
+    }
+  }
+}
+`, rName))
+}
@@ -0,0 +1,592 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timestreaminfluxdb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/timestreaminfluxdb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/timestreaminfluxdb/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="DB Instance")
+// @Tags(identifierAttribute="arn")
+func newDBInstanceResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &dbInstanceResource{}
+
+	// Instances typically take 15-20 minutes to create or update.
+	r.SetDefaultCreateTimeout(40 * time.Minute)
+	r.SetDefaultUpdateTimeout(40 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type dbInstanceResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (*dbInstanceResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_timestreaminfluxdb_db_instance"
+}
+
+func (r *dbInstanceResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	passwordValidators := []validator.String{
+		stringvalidator.LengthBetween(8, 64),
+		stringvalidator.RegexMatches(regexache.MustCompile(`^[a-zA-Z0-9]+$`), "must contain only alphanumeric characters"),
+	}
+	passwordWO := framework.WriteOnlyStringAttribute("password_wo_version")
+	passwordWO.Validators = append(passwordWO.Validators, passwordValidators...)
+	passwordWOVersion := framework.WriteOnlyVersionAttribute("password_wo")
+	// The initial password can't be updated.
+	passwordWOVersion.PlanModifiers = []planmodifier.Int64{
+		int64RequiresReplaceUnlessNullState(),
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrAllocatedStorage: schema.Int64Attribute{
+				Required: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(20, 16384),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrAvailabilityZone: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			// The initial bucket, organization, password and username are only used when the instance is created
+			// and can't be read back. The credentials are stored in the Secrets Manager secret
+			// identified by influx_auth_parameters_secret_arn.
+			// They are null after import, so changing them only requires replacement if they have a prior value.
+			// Removing the password when migrating to password_wo doesn't require replacement either.
+			names.AttrBucket: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					requiresReplaceUnlessNullState(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(2, 64),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[^_][^"]*$`), "must not begin with an underscore or contain double quotes"),
+				},
+			},
+			"db_instance_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.DbInstanceType](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"db_parameter_group_identifier": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 64),
+				},
+			},
+			"db_storage_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.DbStorageType](),
+				Optional:   true,
+				Computed:   true,
+				Default:    fwtypes.StringEnumType[awstypes.DbStorageType]().AttributeDefault(awstypes.DbStorageTypeInfluxIoIncludedT1),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"deployment_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.DeploymentType](),
+				Optional:   true,
+				Computed:   true,
+				Default:    fwtypes.StringEnumType[awstypes.DeploymentType]().AttributeDefault(awstypes.DeploymentTypeSingleAz),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrEndpoint: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"influx_auth_parameters_secret_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 40),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[a-zA-Z][a-zA-Z0-9]*(-[a-zA-Z0-9]+)*$`), "must start with a letter, contain only alphanumeric characters and hyphens, and not end with a hyphen or contain two consecutive hyphens"),
+				},
+			},
+			"organization": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					requiresReplaceUnlessNullState(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+			},
+			names.AttrPassword: schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				PlanModifiers: []planmodifier.String{
+					requiresReplaceUnlessNullState(),
+				},
+				Validators: append(passwordValidators,
+					stringvalidator.ExactlyOneOf(path.MatchRoot("password_wo")),
+				),
+			},
+			"password_wo":         passwordWO,
+			"password_wo_version": passwordWOVersion,
+			names.AttrPubliclyAccessible: schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"secondary_availability_zone": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			names.AttrUsername: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					requiresReplaceUnlessNullState(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+			},
+			"vpc_security_group_ids": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Set{
+					setvalidator.SizeBetween(1, 5),
+				},
+			},
+			"vpc_subnet_ids": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Set{
+					setvalidator.SizeBetween(1, 3),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"log_delivery_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[logDeliveryConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"s3_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[s3ConfigurationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrBucketName: schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrEnabled: schema.BoolAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *dbInstanceResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data dbInstanceResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().TimestreamInfluxDBClient(ctx)
+
+	input := &timestreaminfluxdb.CreateDbInstanceInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	if data.Password.IsNull() {
+		passwordWO, diags := framework.GetWriteOnlyStringValue(ctx, request.Config, path.Root("password_wo"))
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input.Password = fwflex.StringFromFramework(ctx, passwordWO)
+	}
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateDbInstance(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Timestream for InfluxDB DB Instance (%s)", data.Name.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = fwflex.StringToFramework(ctx, output.Id)
+
+	dbInstance, err := waitDBInstanceCreated(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Timestream for InfluxDB DB Instance (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, dbInstance, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *dbInstanceResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data dbInstanceResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().TimestreamInfluxDBClient(ctx)
+
+	output, err := findDBInstanceByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Timestream for InfluxDB DB Instance (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *dbInstanceResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new dbInstanceResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().TimestreamInfluxDBClient(ctx)
+
+	if !new.DBParameterGroupIdentifier.Equal(old.DBParameterGroupIdentifier) ||
+		!new.LogDeliveryConfiguration.Equal(old.LogDeliveryConfiguration) {
+		input := &timestreaminfluxdb.UpdateDbInstanceInput{
+			Identifier: fwflex.StringFromFramework(ctx, new.ID),
+		}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateDbInstance(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Timestream for InfluxDB DB Instance (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		dbInstance, err := waitDBInstanceUpdated(ctx, conn, new.ID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts))
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for Timestream for InfluxDB DB Instance (%s) update", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		response.Diagnostics.Append(fwflex.Flatten(ctx, dbInstance, &new)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *dbInstanceResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data dbInstanceResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().TimestreamInfluxDBClient(ctx)
+
+	_, err := conn.DeleteDbInstance(ctx, &timestreaminfluxdb.DeleteDbInstanceInput{
+		Identifier: fwflex.StringFromFramework(ctx, data.ID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Timestream for InfluxDB DB Instance (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitDBInstanceDeleted(ctx, conn, data.ID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Timestream for InfluxDB DB Instance (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *dbInstanceResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+// requiresReplaceUnlessNullState returns a plan modifier that requires replacement if the value changes,
+// unless the prior value is null, as it is after import, or the planned value is null,
+// as it is when migrating from password to password_wo.
+func requiresReplaceUnlessNullState() planmodifier.String {
+	return stringplanmodifier.RequiresReplaceIf(func(ctx context.Context, request planmodifier.StringRequest, response *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+		response.RequiresReplace = !request.StateValue.IsNull() && !request.PlanValue.IsNull()
+	}, "Changing the value requires replacement unless there is no prior or planned value.", "Changing the value requires replacement unless there is no prior or planned value.")
+}
+
+// int64RequiresReplaceUnlessNullState is the Int64 equivalent of requiresReplaceUnlessNullState.
+func int64RequiresReplaceUnlessNullState() planmodifier.Int64 {
+	return int64planmodifier.RequiresReplaceIf(func(ctx context.Context, request planmodifier.Int64Request, response *int64planmodifier.RequiresReplaceIfFuncResponse) {
+		response.RequiresReplace = !request.StateValue.IsNull() && !request.PlanValue.IsNull()
+	}, "Changing the value requires replacement unless there is no prior or planned value.", "Changing the value requires replacement unless there is no prior or planned value.")
+}
+
+func findDBInstanceByID(ctx context.Context, conn *timestreaminfluxdb.Client, id string) (*timestreaminfluxdb.GetDbInstanceOutput, error) {
+	input := &timestreaminfluxdb.GetDbInstanceInput{
+		Identifier: aws.String(id),
+	}
+
+	output, err := conn.GetDbInstance(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := output.Status; status == awstypes.StatusDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     string(status),
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func statusDBInstance(ctx context.Context, conn *timestreaminfluxdb.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findDBInstanceByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitDBInstanceCreated(ctx context.Context, conn *timestreaminfluxdb.Client, id string, timeout time.Duration) (*timestreaminfluxdb.GetDbInstanceOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.StatusCreating),
+		Target:  enum.Slice(awstypes.StatusAvailable),
+		Refresh: statusDBInstance(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*timestreaminfluxdb.GetDbInstanceOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDBInstanceUpdated(ctx context.Context, conn *timestreaminfluxdb.Client, id string, timeout time.Duration) (*timestreaminfluxdb.GetDbInstanceOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.StatusModifying, awstypes.StatusUpdating),
+		Target:  enum.Slice(awstypes.StatusAvailable),
+		Refresh: statusDBInstance(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*timestreaminfluxdb.GetDbInstanceOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDBInstanceDeleted(ctx context.Context, conn *timestreaminfluxdb.Client, id string, timeout time.Duration) (*timestreaminfluxdb.GetDbInstanceOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.StatusAvailable, awstypes.StatusDeleting),
+		Target:  []string{},
+		Refresh: statusDBInstance(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*timestreaminfluxdb.GetDbInstanceOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+type dbInstanceResourceModel struct {
+	AllocatedStorage              types.Int64                                                    `tfsdk:"allocated_storage"`
+	ARN                           types.String                                                   `tfsdk:"arn"`
+	AvailabilityZone              types.String                                                   `tfsdk:"availability_zone"`
+	Bucket                        types.String                                                   `tfsdk:"bucket"`
+	DBInstanceType                fwtypes.StringEnum[awstypes.DbInstanceType]                    `tfsdk:"db_instance_type"`
+	DBParameterGroupIdentifier    types.String                                                   `tfsdk:"db_parameter_group_identifier"`
+	DBStorageType                 fwtypes.StringEnum[awstypes.DbStorageType]                     `tfsdk:"db_storage_type"`
+	DeploymentType                fwtypes.StringEnum[awstypes.DeploymentType]                    `tfsdk:"deployment_type"`
+	Endpoint                      types.String                                                   `tfsdk:"endpoint"`
+	ID                            types.String                                                   `tfsdk:"id"`
+	InfluxAuthParametersSecretARN types.String                                                   `tfsdk:"influx_auth_parameters_secret_arn"`
+	LogDeliveryConfiguration      fwtypes.ListNestedObjectValueOf[logDeliveryConfigurationModel] `tfsdk:"log_delivery_configuration"`
+	Name                          types.String                                                   `tfsdk:"name"`
+	Organization                  types.String                                                   `tfsdk:"organization"`
+	Password                      types.String                                                   `tfsdk:"password"`
+	PasswordWO                    types.String                                                   `tfsdk:"password_wo"`
+	PasswordWOVersion             types.Int64                                                    `tfsdk:"password_wo_version"`
+	PubliclyAccessible            types.Bool                                                     `tfsdk:"publicly_accessible"`
+	SecondaryAvailabilityZone     types.String                                                   `tfsdk:"secondary_availability_zone"`
+	Tags                          types.Map                                                      `tfsdk:"tags"`
+	TagsAll                       types.Map                                                      `tfsdk:"tags_all"`
+	Timeouts                      timeouts.Value                                                 `tfsdk:"timeouts"`
+	Username                      types.String                                                   `tfsdk:"username"`
+	VPCSecurityGroupIDs           fwtypes.SetValueOf[types.String]                               `tfsdk:"vpc_security_group_ids"`
+	VPCSubnetIDs                  fwtypes.SetValueOf[types.String]                               `tfsdk:"vpc_subnet_ids"`
+}
+
+type logDeliveryConfigurationModel struct {
+	S3Configuration fwtypes.ListNestedObjectValueOf[s3ConfigurationModel] `tfsdk:"s3_configuration"`
+}
+
+type s3ConfigurationModel struct {
+	BucketName types.String `tfsdk:"bucket_name"`
+	Enabled    types.Bool   `tfsdk:"enabled"`
+}
@@ -0,0 +1,192 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timestreaminfluxdb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/timestreaminfluxdb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/timestreaminfluxdb/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="DB Instance")
+func newDBInstanceDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	d := &dbInstanceDataSource{}
+
+	return d, nil
+}
+
+type dbInstanceDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*dbInstanceDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_timestreaminfluxdb_db_instance"
+}
+
+func (d *dbInstanceDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrAllocatedStorage: schema.Int64Attribute{
+				Computed: true,
+			},
+			names.AttrARN: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrAvailabilityZone: schema.StringAttribute{
+				Computed: true,
+			},
+			"db_instance_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.DbInstanceType](),
+				Computed:   true,
+			},
+			"db_parameter_group_identifier": schema.StringAttribute{
+				Computed: true,
+			},
+			"db_storage_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.DbStorageType](),
+				Computed:   true,
+			},
+			"deployment_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.DeploymentType](),
+				Computed:   true,
+			},
+			names.AttrEndpoint: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrID: schema.StringAttribute{
+				Computed: true,
+			},
+			"influx_auth_parameters_secret_arn": schema.StringAttribute{
+				Computed: true,
+			},
+			"log_delivery_configuration": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[logDeliveryConfigurationModel](ctx),
+				Computed:   true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrPubliclyAccessible: schema.BoolAttribute{
+				Computed: true,
+			},
+			"secondary_availability_zone": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.Status](),
+				Computed:   true,
+			},
+			names.AttrTags: tftags.TagsAttributeComputedOnly(),
+			"vpc_security_group_ids": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"vpc_subnet_ids": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *dbInstanceDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data dbInstanceDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().TimestreamInfluxDBClient(ctx)
+	ignoreTagsConfig := d.Meta().IgnoreTagsConfig
+
+	var results []awstypes.DbInstanceSummary
+	pages := timestreaminfluxdb.NewListDbInstancesPaginator(conn, &timestreaminfluxdb.ListDbInstancesInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			response.Diagnostics.AddError("listing Timestream for InfluxDB DB Instances", err.Error())
+
+			return
+		}
+
+		for _, v := range page.Items {
+			if data.Name.ValueString() != aws.ToString(v.Name) {
+				continue
+			}
+
+			results = append(results, v)
+		}
+	}
+
+	if n := len(results); n == 0 {
+		response.Diagnostics.AddError("no matching Timestream for InfluxDB DB Instance found", "")
+
+		return
+	} else if n > 1 {
+		response.Diagnostics.AddError("multiple Timestream for InfluxDB DB Instances matched; use additional constraints to reduce matches to a single Timestream for InfluxDB DB Instance", "")
+
+		return
+	}
+
+	id := aws.ToString(results[0].Id)
+	output, err := findDBInstanceByID(ctx, conn, id)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Timestream for InfluxDB DB Instance (%s)", id), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	tags, err := listTags(ctx, conn, aws.ToString(output.Arn))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("listing tags for Timestream for InfluxDB DB Instance (%s)", id), err.Error())
+
+		return
+	}
+
+	data.Tags = fwflex.FlattenFrameworkStringValueMap(ctx, tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map())
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type dbInstanceDataSourceModel struct {
+	AllocatedStorage              types.Int64                                                    `tfsdk:"allocated_storage"`
+	ARN                           types.String                                                   `tfsdk:"arn"`
+	AvailabilityZone              types.String                                                   `tfsdk:"availability_zone"`
+	DBInstanceType                fwtypes.StringEnum[awstypes.DbInstanceType]                    `tfsdk:"db_instance_type"`
+	DBParameterGroupIdentifier    types.String                                                   `tfsdk:"db_parameter_group_identifier"`
+	DBStorageType                 fwtypes.StringEnum[awstypes.DbStorageType]                     `tfsdk:"db_storage_type"`
+	DeploymentType                fwtypes.StringEnum[awstypes.DeploymentType]                    `tfsdk:"deployment_type"`
+	Endpoint                      types.String                                                   `tfsdk:"endpoint"`
+	ID                            types.String                                                   `tfsdk:"id"`
+	InfluxAuthParametersSecretARN types.String                                                   `tfsdk:"influx_auth_parameters_secret_arn"`
+	LogDeliveryConfiguration      fwtypes.ListNestedObjectValueOf[logDeliveryConfigurationModel] `tfsdk:"log_delivery_configuration"`
+	Name                          types.String                                                   `tfsdk:"name"`
+	PubliclyAccessible            types.Bool                                                     `tfsdk:"publicly_accessible"`
+	SecondaryAvailabilityZone     types.String                                                   `tfsdk:"secondary_availability_zone"`
+	Status                        fwtypes.StringEnum[awstypes.Status]                            `tfsdk:"status"`
+	Tags                          types.Map                                                      `tfsdk:"tags"`
+	VPCSecurityGroupIDs           fwtypes.SetValueOf[types.String]                               `tfsdk:"vpc_security_group_ids"`
+	VPCSubnetIDs                  fwtypes.SetValueOf[types.String]                               `tfsdk:"vpc_subnet_ids"`
+}
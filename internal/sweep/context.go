@@ -12,7 +12,7 @@ import (
 func Context(region string) context.Context {
 	ctx := context.Background()
 
-	ctx = tfsdklog.RegisterStdlogSink(ctx)
+	ctx = tfsdklog.ContextWithStandardLogging(ctx, "sweeper")
 
 	ctx = logger(ctx, "sweeper", region)
 
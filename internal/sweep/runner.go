@@ -0,0 +1,428 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/depgraph"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+)
+
+const defaultSweepParallelism = 10
+
+// The -sweep, -sweep-run and -sweep-allow-failures flags are registered by terraform-plugin-testing.
+var flagSweepParallelism = flag.Int("sweep-parallelism", defaultSweepParallelism, "Maximum number of Sweepers to run concurrently in each Region")
+
+// sweepers is the registry of all sweepers, keyed by resource type.
+var sweepers = make(map[string]*resource.Sweeper)
+
+// AddTestSweepers registers a sweeper with the dependency-ordered sweeper runner.
+// It replaces resource.AddTestSweepers, whose registry is not accessible.
+func AddTestSweepers(name string, s *resource.Sweeper) {
+	if _, ok := sweepers[name]; ok {
+		log.Fatalf("[ERR] Error adding (%s) to sweepers: function already exists in map", name)
+	}
+
+	sweepers[name] = s
+}
+
+// TestMain runs the registered sweepers if the -sweep flag is set, otherwise tests are executed as normal.
+// Sweepers are ordered using a dependency graph built from their declared dependencies.
+// Sweepers with no outstanding dependencies are run concurrently.
+func TestMain(m interface {
+	Run() int
+}) {
+	flag.Parse()
+
+	regions := flagValue("sweep")
+	if regions == "" {
+		resource.TestMain(m)
+		return
+	}
+
+	allowFailures, _ := strconv.ParseBool(flagValue("sweep-allow-failures"))
+
+	if _, err := runSweepers(strings.Split(regions, ","), sweepers, flagValue("sweep-run"), allowFailures, *flagSweepParallelism); err != nil {
+		log.Printf("[ERROR] %s", err)
+		os.Exit(1)
+	}
+}
+
+func flagValue(name string) string {
+	if f := flag.Lookup(name); f != nil {
+		return f.Value.String()
+	}
+
+	return ""
+}
+
+type sweeperStatus string
+
+const (
+	sweeperStatusSucceeded sweeperStatus = "succeeded"
+	sweeperStatusSkipped   sweeperStatus = "skipped"
+	sweeperStatusFailed    sweeperStatus = "failed"
+)
+
+type sweeperResult struct {
+	status sweeperStatus
+	// blockedBy is the name of the failed dependency that caused the sweeper to be skipped.
+	blockedBy string
+	// counted is set if the sweeper reported the number of resources deleted and failed.
+	counted  bool
+	deleted  int
+	failed   int
+	duration time.Duration
+	err      error
+}
+
+// runSweepers runs the sweepers in each of the specified Regions in dependency order.
+// If filter is not empty only sweepers whose names contain one of its comma-separated values, and their dependencies, are run.
+func runSweepers(regions []string, sweepers map[string]*resource.Sweeper, filter string, allowFailures bool, parallelism int) (map[string]map[string]*sweeperResult, error) {
+	g, err := newSweeperGraph(sweepers)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if filter != "" {
+		sweepers, err = filterSweepers(g, sweepers, filter)
+
+		if err != nil {
+			return nil, err
+		}
+
+		g, err = newSweeperGraph(sweepers)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	waves, err := g.Waves()
+
+	if err != nil {
+		return nil, fmt.Errorf("ordering sweepers: %w", err)
+	}
+
+	var sweeperErrorFound bool
+	results := make(map[string]map[string]*sweeperResult)
+
+	for _, region := range regions {
+		region = strings.TrimSpace(region)
+
+		start := time.Now()
+		log.Printf("[DEBUG] Running %d Sweepers in %d waves for region (%s)", g.Len(), len(waves), region)
+
+		regionResults := runSweepersInRegion(region, sweepers, g, waves, allowFailures, parallelism)
+		results[region] = regionResults
+
+		log.Printf("Completed Sweepers for region (%s) in %s", region, time.Since(start))
+		logSummary(region, regionResults)
+
+		if hasFailure(regionResults) {
+			sweeperErrorFound = true
+
+			if !allowFailures {
+				return results, fmt.Errorf("sweepers for region (%s) failed", region)
+			}
+		}
+	}
+
+	if sweeperErrorFound {
+		return results, errors.New("at least one sweeper failed")
+	}
+
+	return results, nil
+}
+
+func hasFailure(results map[string]*sweeperResult) bool {
+	for _, result := range results {
+		if result.status == sweeperStatusFailed {
+			return true
+		}
+	}
+
+	return false
+}
+
+// newSweeperGraph returns a dependency graph of the specified sweepers.
+// Returns an error if a dependency cycle is detected.
+func newSweeperGraph(sweepers map[string]*resource.Sweeper) (*depgraph.Graph, error) {
+	g := depgraph.New()
+	names := tfmaps.Keys(sweepers)
+	slices.Sort(names)
+
+	for _, name := range names {
+		g.AddNode(name)
+	}
+
+	for _, name := range names {
+		for _, dep := range sweepers[name].Dependencies {
+			if !g.HasNode(dep) {
+				log.Printf("[WARN] Sweeper (%s) has dependency (%s), but that sweeper was not found", name, dep)
+				continue
+			}
+
+			if err := g.AddDependency(name, dep); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if _, err := g.OverallOrder(); err != nil {
+		return nil, fmt.Errorf("ordering sweepers: %w", err)
+	}
+
+	return g, nil
+}
+
+// filterSweepers returns the sweepers whose names contain one of the specified comma-separated values, along with their dependencies.
+func filterSweepers(g *depgraph.Graph, sweepers map[string]*resource.Sweeper, filter string) (map[string]*resource.Sweeper, error) {
+	filters := strings.Split(strings.ToLower(filter), ",")
+	result := make(map[string]*resource.Sweeper)
+
+	for name, sweeper := range sweepers {
+		if !slices.ContainsFunc(filters, func(f string) bool {
+			return strings.Contains(strings.ToLower(name), f)
+		}) {
+			continue
+		}
+
+		result[name] = sweeper
+
+		deps, err := g.DependenciesOf(name)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, dep := range deps {
+			result[dep] = sweepers[dep]
+		}
+	}
+
+	return result, nil
+}
+
+// runSweepersInRegion runs the sweepers in the specified Region, one wave at a time.
+// A sweeper is skipped if any of its dependencies failed or were themselves skipped because of a failure.
+// If allowFailures is not set no further waves are started once a sweeper has failed.
+func runSweepersInRegion(region string, sweepers map[string]*resource.Sweeper, g *depgraph.Graph, waves [][]string, allowFailures bool, parallelism int) map[string]*sweeperResult {
+	var mu sync.Mutex
+	results := make(map[string]*sweeperResult)
+	sem := make(chan struct{}, max(parallelism, 1))
+	var failed string
+
+	for _, wave := range waves {
+		var wg sync.WaitGroup
+
+		for _, name := range wave {
+			mu.Lock()
+			blockedBy := failed
+			if blockedBy == "" {
+				blockedBy = blockingDependency(g, name, results)
+			}
+			if blockedBy != "" {
+				results[name] = &sweeperResult{
+					status:    sweeperStatusSkipped,
+					blockedBy: blockedBy,
+				}
+			}
+			mu.Unlock()
+
+			if blockedBy != "" {
+				log.Printf("[WARN] Skipping Sweeper (%s) in region (%s): sweeper (%s) failed", name, region, blockedBy)
+				continue
+			}
+
+			wg.Add(1)
+			sem <- struct{}{}
+
+			go func() {
+				defer func() {
+					<-sem
+					wg.Done()
+				}()
+
+				result := runSweeper(region, sweepers[name])
+
+				mu.Lock()
+				defer mu.Unlock()
+
+				results[name] = result
+			}()
+		}
+
+		wg.Wait()
+
+		if !allowFailures && failed == "" {
+			for _, name := range wave {
+				if results[name].status == sweeperStatusFailed {
+					failed = name
+					break
+				}
+			}
+		}
+	}
+
+	return results
+}
+
+// blockingDependency returns the name of a direct dependency of the specified sweeper that failed or was skipped because of a failure.
+// It must only be called once all of the sweeper's dependencies have completed.
+func blockingDependency(g *depgraph.Graph, name string, results map[string]*sweeperResult) string {
+	deps, _ := g.DirectDependenciesOf(name)
+
+	for _, dep := range deps {
+		if result, ok := results[dep]; ok {
+			if result.status == sweeperStatusFailed {
+				return dep
+			}
+			if result.blockedBy != "" {
+				return result.blockedBy
+			}
+		}
+	}
+
+	return ""
+}
+
+func runSweeper(region string, s *resource.Sweeper) *sweeperResult {
+	log.Printf("[DEBUG] Running Sweeper (%s) in region (%s)", s.Name, region)
+
+	start := time.Now()
+	err := s.F(region)
+	elapsed := time.Since(start)
+
+	log.Printf("[DEBUG] Completed Sweeper (%s) in region (%s) in %s", s.Name, region, elapsed)
+
+	result := &sweeperResult{
+		status:   sweeperStatusSucceeded,
+		duration: elapsed,
+		err:      err,
+	}
+
+	if stats, ok := sweepStats.get(region, s.Name); ok {
+		result.counted = true
+		result.deleted = stats.deleted
+		result.failed = stats.failed
+
+		if stats.skipped {
+			result.status = sweeperStatusSkipped
+		}
+	}
+
+	if err != nil {
+		log.Printf("[ERROR] Error running Sweeper (%s) in region (%s): %s", s.Name, region, err)
+		result.status = sweeperStatusFailed
+	}
+
+	return result
+}
+
+func logSummary(region string, results map[string]*sweeperResult) {
+	var sb strings.Builder
+	counts := make(map[sweeperStatus]int)
+	w := tabwriter.NewWriter(&sb, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(w, "\tRESOURCE TYPE\tSTATUS\tDELETED\tFAILED\tDURATION\tDETAIL")
+
+	names := tfmaps.Keys(results)
+	slices.Sort(names)
+
+	for _, name := range names {
+		result := results[name]
+		counts[result.status]++
+
+		deleted, failed := "-", "-"
+		if result.counted {
+			deleted, failed = strconv.Itoa(result.deleted), strconv.Itoa(result.failed)
+		}
+
+		var detail string
+		switch {
+		case result.err != nil:
+			detail = strings.ReplaceAll(result.err.Error(), "\n", " ")
+		case result.blockedBy != "":
+			detail = fmt.Sprintf("sweeper (%s) failed", result.blockedBy)
+		}
+
+		fmt.Fprintf(w, "\t%s\t%s\t%s\t%s\t%s\t%s\n", name, result.status, deleted, failed, result.duration.Round(time.Millisecond), detail)
+	}
+
+	w.Flush()
+
+	log.Printf("Sweeper summary for region (%s): %d succeeded, %d skipped, %d failed\n%s", region,
+		counts[sweeperStatusSucceeded], counts[sweeperStatusSkipped], counts[sweeperStatusFailed], sb.String())
+}
+
+type sweepCounts struct {
+	deleted int
+	failed  int
+	skipped bool
+}
+
+// sweepResults records the outcome of sweepers registered via Register.
+type sweepResults struct {
+	mu     sync.Mutex
+	counts map[string]sweepCounts
+}
+
+var sweepStats = &sweepResults{
+	counts: make(map[string]sweepCounts),
+}
+
+func (r *sweepResults) key(region, name string) string {
+	return region + "/" + name
+}
+
+func (r *sweepResults) skipped(region, name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.counts[r.key(region, name)] = sweepCounts{skipped: true}
+}
+
+func (r *sweepResults) swept(region, name string, n int, err error) {
+	var failed int
+	if err != nil {
+		failed = 1
+
+		var errs *multierror.Error
+		if errors.As(err, &errs) {
+			failed = len(errs.Errors)
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.counts[r.key(region, name)] = sweepCounts{
+		deleted: n - failed,
+		failed:  failed,
+	}
+}
+
+func (r *sweepResults) get(region, name string) (sweepCounts, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	counts, ok := r.counts[r.key(region, name)]
+
+	return counts, ok
+}
@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+type sweeperRecorder struct {
+	mu    sync.Mutex
+	order []string
+}
+
+func (r *sweeperRecorder) sweeper(name string, err error, dependencies ...string) *resource.Sweeper {
+	return &resource.Sweeper{
+		Name:         name,
+		Dependencies: dependencies,
+		F: func(region string) error {
+			r.mu.Lock()
+			defer r.mu.Unlock()
+
+			r.order = append(r.order, name)
+
+			return err
+		},
+	}
+}
+
+func (r *sweeperRecorder) ran(name string) bool {
+	return slices.Contains(r.order, name)
+}
+
+func (r *sweeperRecorder) ranBefore(first, second string) bool {
+	return slices.Index(r.order, first) < slices.Index(r.order, second)
+}
+
+func TestRunSweepers(t *testing.T) {
+	t.Parallel()
+
+	var r sweeperRecorder
+	sweepers := map[string]*resource.Sweeper{
+		"aws_a": r.sweeper("aws_a", nil, "aws_b", "aws_c"),
+		"aws_b": r.sweeper("aws_b", nil, "aws_d"),
+		"aws_c": r.sweeper("aws_c", nil, "aws_d", "aws_unknown"),
+		"aws_d": r.sweeper("aws_d", nil),
+		"aws_e": r.sweeper("aws_e", nil),
+	}
+
+	results, err := runSweepers([]string{"us-west-2"}, sweepers, "", false, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := len(r.order), 5; got != expected {
+		t.Fatalf("incorrect number of sweepers run. Expected: %d, got: %d", expected, got)
+	}
+	for _, v := range [][2]string{{"aws_d", "aws_b"}, {"aws_d", "aws_c"}, {"aws_b", "aws_a"}, {"aws_c", "aws_a"}} {
+		if !r.ranBefore(v[0], v[1]) {
+			t.Errorf("expected %s to run before %s, got: %v", v[0], v[1], r.order)
+		}
+	}
+
+	for name, result := range results["us-west-2"] {
+		if got, expected := result.status, sweeperStatusSucceeded; got != expected {
+			t.Errorf("incorrect status for %s. Expected: %s, got: %s", name, expected, got)
+		}
+	}
+}
+
+func TestRunSweepersFilter(t *testing.T) {
+	t.Parallel()
+
+	var r sweeperRecorder
+	sweepers := map[string]*resource.Sweeper{
+		"aws_a": r.sweeper("aws_a", nil, "aws_b"),
+		"aws_b": r.sweeper("aws_b", nil, "aws_c"),
+		"aws_c": r.sweeper("aws_c", nil),
+		"aws_d": r.sweeper("aws_d", nil),
+	}
+
+	_, err := runSweepers([]string{"us-west-2"}, sweepers, "AWS_B", false, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if expected := []string{"aws_c", "aws_b"}; !cmp.Equal(r.order, expected) {
+		t.Fatalf("incorrect sweepers run. Expected: %v, got: %v", expected, r.order)
+	}
+}
+
+func TestRunSweepersCycle(t *testing.T) {
+	t.Parallel()
+
+	var r sweeperRecorder
+	sweepers := map[string]*resource.Sweeper{
+		"aws_a": r.sweeper("aws_a", nil, "aws_b"),
+		"aws_b": r.sweeper("aws_b", nil, "aws_c"),
+		"aws_c": r.sweeper("aws_c", nil, "aws_a"),
+		"aws_d": r.sweeper("aws_d", nil),
+	}
+
+	_, err := runSweepers([]string{"us-west-2"}, sweepers, "", true, 2)
+	if err == nil {
+		t.Fatalf("expected error")
+	}
+
+	if len(r.order) != 0 {
+		t.Fatalf("expected no sweepers to run, got: %v", r.order)
+	}
+}
+
+func TestRunSweepersFailure(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		allowFailures bool
+		expectedRun   []string
+		expectedSkip  []string
+	}{
+		"allow failures": {
+			allowFailures: true,
+			expectedRun:   []string{"aws_b", "aws_d", "aws_e"},
+			expectedSkip:  []string{"aws_a", "aws_c"},
+		},
+		"stop on failure": {
+			allowFailures: false,
+			expectedRun:   []string{"aws_b", "aws_d"},
+			expectedSkip:  []string{"aws_a", "aws_c", "aws_e"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var r sweeperRecorder
+			sweepers := map[string]*resource.Sweeper{
+				"aws_a": r.sweeper("aws_a", nil, "aws_c"),
+				"aws_b": r.sweeper("aws_b", errors.New("DependencyViolation")),
+				"aws_c": r.sweeper("aws_c", nil, "aws_b"),
+				"aws_d": r.sweeper("aws_d", nil),
+				"aws_e": r.sweeper("aws_e", nil, "aws_d"),
+			}
+
+			results, err := runSweepers([]string{"us-west-2"}, sweepers, "", testCase.allowFailures, 2)
+			if err == nil {
+				t.Fatalf("expected error")
+			}
+
+			for _, name := range testCase.expectedRun {
+				if !r.ran(name) {
+					t.Errorf("expected %s to run, got: %v", name, r.order)
+				}
+			}
+
+			for _, name := range testCase.expectedSkip {
+				if r.ran(name) {
+					t.Errorf("expected %s not to run, got: %v", name, r.order)
+				}
+				if got, expected := results["us-west-2"][name].status, sweeperStatusSkipped; got != expected {
+					t.Errorf("incorrect status for %s. Expected: %s, got: %s", name, expected, got)
+				}
+			}
+
+			if got, expected := results["us-west-2"]["aws_b"].status, sweeperStatusFailed; got != expected {
+				t.Errorf("incorrect status for aws_b. Expected: %s, got: %s", expected, got)
+			}
+		})
+	}
+}
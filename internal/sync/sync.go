@@ -4,6 +4,7 @@
 package sync
 
 import (
+	"context"
 	"os"
 	"strconv"
 	"sync"
@@ -23,8 +24,12 @@ var semaphoreKV = &struct {
 	store: make(map[string]Semaphore),
 }
 
-// GetSemaphore returns a named semaphore with a default capacity or overrides it using an environment variable
-// NOTE: this is currently an experimental feature and is likely to change. DO NOT USE.
+// NewSemaphore returns a semaphore with the specified capacity.
+func NewSemaphore(limit int) Semaphore {
+	return make(Semaphore, limit)
+}
+
+// GetSemaphore returns a named semaphore with a default capacity or overrides it using an environment variable.
 func GetSemaphore(key, envvar string, defaultLimit int) Semaphore {
 	semaphoreKV.lock.Lock()
 	defer semaphoreKV.lock.Unlock()
@@ -38,21 +43,30 @@ func GetSemaphore(key, envvar string, defaultLimit int) Semaphore {
 			}
 		}
 
-		semaphore = make(Semaphore, limit)
+		semaphore = NewSemaphore(limit)
 		semaphoreKV.store[key] = semaphore
 	}
 
 	return semaphore
 }
 
-// Wait waits for a semaphore before continuing
-// NOTE: this is currently an experimental feature and is likely to change. DO NOT USE.
+// Wait waits for a semaphore before continuing.
 func (s Semaphore) Wait() {
 	s <- struct{}{}
 }
 
-// Notify releases a semaphore
-// NOTE: this is currently an experimental feature and is likely to change. DO NOT USE.
+// Acquire waits for a semaphore before continuing.
+// An error is returned if the context is done before the semaphore is acquired.
+func (s Semaphore) Acquire(ctx context.Context) error {
+	select {
+	case s <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Notify releases a semaphore.
 func (s Semaphore) Notify() {
 	// Make the Notify non-blocking. This can happen if a Wait was never issued
 	select {
@@ -62,8 +76,7 @@ func (s Semaphore) Notify() {
 	}
 }
 
-// TestAccPreCheckSyncronized waits for a semaphore and skips the test if there is no capacity
-// NOTE: this is currently an experimental feature and is likely to change. DO NOT USE.
+// TestAccPreCheckSyncronized waits for a semaphore and skips the test if there is no capacity.
 func TestAccPreCheckSyncronize(t testing.T, semaphore Semaphore, resource string) {
 	if cap(semaphore) == 0 {
 		t.Skipf("concurrency for %s testing set to 0", resource)
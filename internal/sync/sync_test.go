@@ -0,0 +1,34 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sync
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestSemaphoreAcquire(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	s := NewSemaphore(1)
+
+	if err := s.Acquire(ctx); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	cancel()
+
+	if err := s.Acquire(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got: %v", err)
+	}
+
+	s.Notify()
+
+	if err := s.Acquire(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
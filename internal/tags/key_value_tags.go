@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"net/url"
 	"reflect"
+	"regexp"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -39,6 +41,9 @@ type DefaultConfig struct {
 type IgnoreConfig struct {
 	Keys        KeyValueTags
 	KeyPrefixes KeyValueTags
+	KeyRegexes  []*regexp.Regexp
+	// KeyValuePairs removes a tag only if both its key and value match.
+	KeyValuePairs KeyValueTags
 }
 
 // KeyValueTags is a standard implementation for AWS key-value resource tags.
@@ -107,6 +112,8 @@ func (tags KeyValueTags) IgnoreConfig(config *IgnoreConfig) KeyValueTags {
 
 	result := tags.IgnorePrefixes(config.KeyPrefixes)
 	result = result.Ignore(config.Keys)
+	result = result.IgnoreRegexes(config.KeyRegexes)
+	result = result.IgnoreKeyValuePairs(config.KeyValuePairs)
 
 	return result
 }
@@ -158,6 +165,38 @@ func (tags KeyValueTags) IgnorePrefixes(ignoreTagPrefixes KeyValueTags) KeyValue
 	return result
 }
 
+// IgnoreRegexes returns tag keys not matching any of the regular expressions.
+func (tags KeyValueTags) IgnoreRegexes(ignoreTagRegexes []*regexp.Regexp) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		if slices.ContainsFunc(ignoreTagRegexes, func(re *regexp.Regexp) bool {
+			return re.MatchString(k)
+		}) {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
+// IgnoreKeyValuePairs returns tags not matching both the key and value of any of the ignored tags.
+func (tags KeyValueTags) IgnoreKeyValuePairs(ignoreTags KeyValueTags) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		if w, ok := ignoreTags[k]; ok && v.ValueString() == w.ValueString() {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
 // IgnoreServerlessApplicationRepository returns non-AWS and non-ServerlessApplicationRepository tag keys.
 func (tags KeyValueTags) IgnoreServerlessApplicationRepository() KeyValueTags {
 	result := make(KeyValueTags)
@@ -5,8 +5,10 @@ package tags
 
 import (
 	"context"
+	"regexp"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -568,6 +570,60 @@ func TestKeyValueTagsIgnoreConfig(t *testing.T) {
 				"key3": "value3",
 			},
 		},
+		{
+			name: "key regexes some matching",
+			tags: New(ctx, map[string]string{
+				"CostCenter-20240101":           "value1",
+				"kubernetes.io/cluster/cluster": "owned",
+				"key3":                          "value3",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				KeyRegexes: []*regexp.Regexp{
+					regexache.MustCompile(`^CostCenter-\d+$`),
+					regexache.MustCompile(`^kubernetes\.io/cluster/`),
+				},
+			},
+			want: map[string]string{
+				"key3": "value3",
+			},
+		},
+		{
+			name: "key value pairs some matching",
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				KeyValuePairs: New(ctx, map[string]string{
+					"key1": "value1",
+					"key2": "other",
+				}),
+			},
+			want: map[string]string{
+				"key2": "value2",
+				"key3": "value3",
+			},
+		},
+		{
+			name: "all options",
+			tags: New(ctx, map[string]string{
+				"key1":   "value1",
+				"key2":   "value2",
+				"key3":   "value3",
+				"prefix": "value4",
+				"other":  "value5",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				Keys:          New(ctx, []string{"key1"}),
+				KeyPrefixes:   New(ctx, []string{"pre"}),
+				KeyRegexes:    []*regexp.Regexp{regexache.MustCompile(`2$`)},
+				KeyValuePairs: New(ctx, map[string]string{"key3": "value3"}),
+			},
+			want: map[string]string{
+				"other": "value5",
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -646,6 +702,133 @@ func TestKeyValueTagsIgnoreElasticbeanstalk(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsIgnoreRegexes(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := []struct {
+		name             string
+		tags             KeyValueTags
+		ignoreTagRegexes []*regexp.Regexp
+		want             map[string]string
+	}{
+		{
+			name:             "empty",
+			tags:             New(ctx, map[string]string{}),
+			ignoreTagRegexes: []*regexp.Regexp{regexache.MustCompile(`.*`)},
+			want:             map[string]string{},
+		},
+		{
+			name: "none",
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		},
+		{
+			name: "unanchored",
+			tags: New(ctx, map[string]string{
+				"key1":     "value1",
+				"key2":     "value2",
+				"otherkey": "value3",
+			}),
+			ignoreTagRegexes: []*regexp.Regexp{regexache.MustCompile(`y[12]`)},
+			want: map[string]string{
+				"otherkey": "value3",
+			},
+		},
+		{
+			name: "anchored",
+			tags: New(ctx, map[string]string{
+				"key1":     "value1",
+				"key2":     "value2",
+				"otherkey": "value3",
+			}),
+			ignoreTagRegexes: []*regexp.Regexp{regexache.MustCompile(`^key`)},
+			want: map[string]string{
+				"otherkey": "value3",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.tags.IgnoreRegexes(testCase.ignoreTagRegexes)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsIgnoreKeyValuePairs(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := []struct {
+		name       string
+		tags       KeyValueTags
+		ignoreTags KeyValueTags
+		want       map[string]string
+	}{
+		{
+			name:       "empty",
+			tags:       New(ctx, map[string]string{}),
+			ignoreTags: New(ctx, map[string]string{"key1": "value1"}),
+			want:       map[string]string{},
+		},
+		{
+			name: "key and value match",
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			ignoreTags: New(ctx, map[string]string{"key1": "value1"}),
+			want: map[string]string{
+				"key2": "value2",
+			},
+		},
+		{
+			name: "key matches",
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			ignoreTags: New(ctx, map[string]string{"key1": "value2"}),
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		},
+		{
+			name: "empty value",
+			tags: New(ctx, map[string]string{
+				"key1": "",
+				"key2": "value2",
+			}),
+			ignoreTags: New(ctx, map[string]string{"key1": ""}),
+			want: map[string]string{
+				"key2": "value2",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.tags.IgnoreKeyValuePairs(testCase.ignoreTags)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
 func TestKeyValueTagsIgnorePrefixes(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,159 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+)
+
+// Case is a tag key or value case rule.
+type Case string
+
+const (
+	CaseCamel  Case = "camel"
+	CaseLower  Case = "lower"
+	CasePascal Case = "pascal"
+	CaseUpper  Case = "upper"
+)
+
+func (Case) Values() []Case {
+	return []Case{
+		CaseCamel,
+		CaseLower,
+		CasePascal,
+		CaseUpper,
+	}
+}
+
+var (
+	camelCaseRegexp  = regexache.MustCompile(`^[a-z][0-9A-Za-z]*$`)
+	pascalCaseRegexp = regexache.MustCompile(`^[A-Z][0-9A-Za-z]*$`)
+)
+
+// Matches returns whether the specified string satisfies the case rule.
+// An empty rule matches any string.
+func (c Case) Matches(s string) bool {
+	switch c {
+	case CaseCamel:
+		return camelCaseRegexp.MatchString(s)
+	case CaseLower:
+		return s == strings.ToLower(s)
+	case CasePascal:
+		return pascalCaseRegexp.MatchString(s)
+	case CaseUpper:
+		return s == strings.ToUpper(s)
+	default:
+		return true
+	}
+}
+
+const (
+	// TagSourceDefaultTags indicates that a tag was configured in the provider's default_tags.
+	TagSourceDefaultTags = "default_tags"
+	// TagSourceTags indicates that a tag was configured in the resource's tags.
+	TagSourceTags = "tags"
+)
+
+// PolicyConfig contains an organization's tag policy, validated against all taggable resources.
+type PolicyConfig struct {
+	// AllowedValues maps tag keys to the pattern that the tag's value must match.
+	AllowedValues map[string]*regexp.Regexp
+	KeyCase       Case
+	RequiredKeys  []string
+	ValueCase     Case
+}
+
+// PolicyViolation describes a tag that does not comply with a tag policy.
+type PolicyViolation struct {
+	Key string
+	// Source is where the tag was configured, either TagSourceDefaultTags or TagSourceTags.
+	// Source is empty for a missing required tag.
+	Source  string
+	Message string
+}
+
+func (v PolicyViolation) Error() string {
+	if v.Source == "" {
+		return fmt.Sprintf("tag %q: %s", v.Key, v.Message)
+	}
+
+	return fmt.Sprintf("tag %q (from %s): %s", v.Key, v.Source, v.Message)
+}
+
+// Validate returns any violations of the tag policy by the merger of resource tags on to those defined at the provider-level.
+// System tags and tags ignored by the provider's ignore_tags configuration are not validated, the same as they are excluded from tags_all.
+func (pc *PolicyConfig) Validate(defaultConfig *DefaultConfig, ignoreConfig *IgnoreConfig, resourceTags KeyValueTags) []PolicyViolation {
+	if pc == nil {
+		return nil
+	}
+
+	var violations []PolicyViolation
+
+	allTags := defaultConfig.MergeTags(resourceTags).IgnoreAWS().IgnoreConfig(ignoreConfig).Map()
+	keys := tfmaps.Keys(allTags)
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		value := allTags[key]
+		source := TagSourceDefaultTags
+		if resourceTags.KeyExists(key) {
+			source = TagSourceTags
+		}
+
+		if !pc.KeyCase.Matches(key) {
+			violations = append(violations, PolicyViolation{
+				Key:     key,
+				Source:  source,
+				Message: fmt.Sprintf("key must be %s case", pc.KeyCase),
+			})
+		}
+
+		if !pc.ValueCase.Matches(value) {
+			violations = append(violations, PolicyViolation{
+				Key:     key,
+				Source:  source,
+				Message: fmt.Sprintf("value %q must be %s case", value, pc.ValueCase),
+			})
+		}
+
+		if re, ok := pc.AllowedValues[key]; ok && !re.MatchString(value) {
+			violations = append(violations, PolicyViolation{
+				Key:     key,
+				Source:  source,
+				Message: fmt.Sprintf("value %q does not match allowed pattern %q", value, re.String()),
+			})
+		}
+	}
+
+	for _, key := range pc.RequiredKeys {
+		// A required tag whose key is ignored is managed outside of Terraform.
+		if ignoreConfig.ignoresKey(key) {
+			continue
+		}
+
+		if _, ok := allTags[key]; !ok {
+			violations = append(violations, PolicyViolation{
+				Key:     key,
+				Message: fmt.Sprintf("required tag is missing from both %s and %s", TagSourceDefaultTags, TagSourceTags),
+			})
+		}
+	}
+
+	return violations
+}
+
+// ignoresKey returns whether all tags with the specified key are ignored, regardless of their value.
+func (config *IgnoreConfig) ignoresKey(key string) bool {
+	if config == nil {
+		return false
+	}
+
+	return len(KeyValueTags{key: nil}.IgnorePrefixes(config.KeyPrefixes).Ignore(config.Keys).IgnoreRegexes(config.KeyRegexes)) == 0
+}
@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCaseMatches(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		c    Case
+		s    string
+		want bool
+	}{
+		{"", "any Thing", true},
+		{CaseCamel, "costCenter", true},
+		{CaseCamel, "CostCenter", false},
+		{CaseCamel, "cost-center", false},
+		{CaseLower, "cost-center", true},
+		{CaseLower, "Cost-Center", false},
+		{CasePascal, "CostCenter", true},
+		{CasePascal, "costCenter", false},
+		{CasePascal, "Cost Center", false},
+		{CaseUpper, "COST_CENTER", true},
+		{CaseUpper, "Cost_Center", false},
+	}
+
+	for _, testCase := range testCases {
+		if got := testCase.c.Matches(testCase.s); got != testCase.want {
+			t.Errorf("Case(%q).Matches(%q) = %t, want %t", testCase.c, testCase.s, got, testCase.want)
+		}
+	}
+}
+
+func TestPolicyConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := []struct {
+		name          string
+		policyConfig  *PolicyConfig
+		defaultConfig *DefaultConfig
+		ignoreConfig  *IgnoreConfig
+		tags          KeyValueTags
+		want          []PolicyViolation
+	}{
+		{
+			name: "no policy",
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+			}),
+		},
+		{
+			name:         "compliant",
+			policyConfig: &PolicyConfig{KeyCase: CasePascal, RequiredKeys: []string{"Environment", "Owner"}},
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"Owner": "platform",
+				}),
+			},
+			tags: New(ctx, map[string]string{
+				"Environment": "prod",
+			}),
+		},
+		{
+			name:         "system tags",
+			policyConfig: &PolicyConfig{KeyCase: CasePascal},
+			tags: New(ctx, map[string]string{
+				"aws:cloudformation:stack-name": "stack",
+			}),
+		},
+		{
+			name:         "required key missing",
+			policyConfig: &PolicyConfig{RequiredKeys: []string{"Environment", "Owner"}},
+			tags: New(ctx, map[string]string{
+				"Owner": "platform",
+			}),
+			want: []PolicyViolation{
+				{Key: "Environment", Message: `required tag is missing from both default_tags and tags`},
+			},
+		},
+		{
+			name:         "key case",
+			policyConfig: &PolicyConfig{KeyCase: CasePascal},
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"cost-center": "1234",
+					"owner":       "platform",
+				}),
+			},
+			tags: New(ctx, map[string]string{
+				"owner": "team",
+			}),
+			want: []PolicyViolation{
+				{Key: "cost-center", Source: TagSourceDefaultTags, Message: `key must be pascal case`},
+				{Key: "owner", Source: TagSourceTags, Message: `key must be pascal case`},
+			},
+		},
+		{
+			name:         "value case",
+			policyConfig: &PolicyConfig{ValueCase: CaseLower},
+			tags: New(ctx, map[string]string{
+				"Environment": "Prod",
+			}),
+			want: []PolicyViolation{
+				{Key: "Environment", Source: TagSourceTags, Message: `value "Prod" must be lower case`},
+			},
+		},
+		{
+			name: "allowed values",
+			policyConfig: &PolicyConfig{
+				AllowedValues: map[string]*regexp.Regexp{
+					"Environment": regexache.MustCompile(`^(?:dev|prod)$`),
+				},
+			},
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"Environment": "dev",
+				}),
+			},
+			tags: New(ctx, map[string]string{
+				"Environment": "staging",
+			}),
+			want: []PolicyViolation{
+				{Key: "Environment", Source: TagSourceTags, Message: `value "staging" does not match allowed pattern "^(?:dev|prod)$"`},
+			},
+		},
+		{
+			name:         "ignored tags",
+			policyConfig: &PolicyConfig{KeyCase: CasePascal, RequiredKeys: []string{"Environment", "cost-center"}},
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"Environment": "prod",
+				}),
+			},
+			ignoreConfig: &IgnoreConfig{
+				Keys:        New(ctx, []string{"cost-center"}),
+				KeyPrefixes: New(ctx, []string{"kubernetes.io/"}),
+			},
+			tags: New(ctx, map[string]string{
+				"kubernetes.io/cluster/test": "owned",
+			}),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.policyConfig.Validate(testCase.defaultConfig, testCase.ignoreConfig, testCase.tags)
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestPolicyViolationError(t *testing.T) {
+	t.Parallel()
+
+	if got, want := (PolicyViolation{Key: "Owner", Source: TagSourceDefaultTags, Message: "key must be lower case"}).Error(), `tag "Owner" (from default_tags): key must be lower case`; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	if got, want := (PolicyViolation{Key: "Owner", Message: "required tag is missing"}).Error(), `tag "Owner": required tag is missing`; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
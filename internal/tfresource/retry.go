@@ -15,6 +15,7 @@ import (
 	tfawserr_sdkv2 "github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/retrypolicy"
 )
 
 // Retryable is a function that is used to decide if a function's error is retryable or not.
@@ -372,12 +373,26 @@ func Retry(ctx context.Context, timeout time.Duration, f retry.RetryFunc, optFns
 		fn(&options)
 	}
 
+	// Any practitioner configured retry policy overrides the timeout and minimum wait between attempts.
+	policy, _ := retrypolicy.RetryPolicyFromContext(ctx)
+	timeout = policy.Timeout(timeout)
+	if policy.BaseDelay > 0 {
+		options.MinPollInterval = policy.BaseDelay
+	}
+
+	var attempt int
+
 	c := &retry.StateChangeConf{
 		Pending:    []string{"retryableerror"},
 		Target:     []string{"success"},
 		Timeout:    timeout,
 		MinTimeout: 500 * time.Millisecond,
 		Refresh: func() (interface{}, string, error) {
+			if attempt > 0 {
+				sleepContext(ctx, policy.JitterDelay())
+			}
+			attempt++
+
 			rerr := f()
 
 			resultErrMu.Lock()
@@ -417,6 +432,21 @@ func Retry(ctx context.Context, timeout time.Duration, f retry.RetryFunc, optFns
 	return resultErr
 }
 
+// sleepContext sleeps for the specified duration or until ctx is done, whichever occurs first.
+func sleepContext(ctx context.Context, d time.Duration) {
+	if d <= 0 {
+		return
+	}
+
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+	case <-t.C:
+	}
+}
+
 type deadline time.Time
 
 func NewDeadline(duration time.Duration) deadline {
@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/retrypolicy"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -397,6 +398,41 @@ func TestRetryContext_error(t *testing.T) {
 	}
 }
 
+func TestRetryContext_retryPolicy(t *testing.T) {
+	ctx := acctest.Context(t)
+	t.Parallel()
+
+	ctx = retrypolicy.WithRetryPolicy(ctx, retrypolicy.RetryPolicy{
+		BaseDelay:  10 * time.Millisecond,
+		Jitter:     10 * time.Millisecond,
+		MaxElapsed: 1 * time.Second,
+	})
+
+	expected := fmt.Errorf("again")
+	var attempts atomic.Int32
+	f := func() *retry.RetryError {
+		attempts.Add(1)
+		return retry.RetryableError(expected)
+	}
+
+	errCh := make(chan error)
+	go func() {
+		errCh <- tfresource.Retry(ctx, 1*time.Hour, f)
+	}()
+
+	select {
+	case err := <-errCh:
+		if err != expected { //nolint: errorlint // We are actually comparing equality
+			t.Fatalf("bad: %#v", err)
+		}
+		if got := attempts.Load(); got < 2 {
+			t.Fatalf("attempts = %d, want at least 2", got)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("retry policy not honored")
+	}
+}
+
 func TestOptionsApply(t *testing.T) {
 	t.Parallel()
 
@@ -6,7 +6,10 @@ package types
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -17,6 +20,20 @@ type ServicePackageResourceTags struct {
 	ResourceType        string // Extra resourceType parameter value for UpdateTags etc.
 }
 
+// ServicePackageResourceIdentity represents resource-level identity information.
+// A resource's identity comprises the AWS account ID, the Region (for non-global resources) and the natural key attributes.
+type ServicePackageResourceIdentity struct {
+	Attributes []string // The natural key attributes, in import ID order.
+	Global     bool     // Whether the resource is global, i.e. its identity does not include the Region.
+}
+
+// ServicePackageAction represents a Terraform Plugin Framework action
+// implemented by a service package.
+type ServicePackageAction struct {
+	Factory func(context.Context) (action.ActionWithConfigure, error)
+	Name    string
+}
+
 // ServicePackageFrameworkDataSource represents a Terraform Plugin Framework data source
 // implemented by a service package.
 type ServicePackageFrameworkDataSource struct {
@@ -25,12 +42,20 @@ type ServicePackageFrameworkDataSource struct {
 	Tags    *ServicePackageResourceTags
 }
 
+// ServicePackageEphemeralResource represents a Terraform Plugin Framework ephemeral resource
+// implemented by a service package.
+type ServicePackageEphemeralResource struct {
+	Factory func(context.Context) (ephemeral.EphemeralResourceWithConfigure, error)
+	Name    string
+}
+
 // ServicePackageFrameworkResource represents a Terraform Plugin Framework resource
 // implemented by a service package.
 type ServicePackageFrameworkResource struct {
-	Factory func(context.Context) (resource.ResourceWithConfigure, error)
-	Name    string
-	Tags    *ServicePackageResourceTags
+	Factory  func(context.Context) (resource.ResourceWithConfigure, error)
+	Name     string
+	Tags     *ServicePackageResourceTags
+	Identity *ServicePackageResourceIdentity
 }
 
 // ServicePackageSDKDataSource represents a Terraform Plugin SDK data source
@@ -42,6 +67,14 @@ type ServicePackageSDKDataSource struct {
 	Tags     *ServicePackageResourceTags
 }
 
+// ServicePackageSDKListResource represents a Terraform Plugin Framework list resource
+// that lists the objects managed by a Terraform Plugin SDK resource implemented by a service package.
+type ServicePackageSDKListResource struct {
+	Factory  func(context.Context) (list.ListResourceWithConfigure, error)
+	TypeName string
+	Name     string
+}
+
 // ServicePackageSDKResource represents a Terraform Plugin SDK resource
 // implemented by a service package.
 type ServicePackageSDKResource struct {
@@ -49,4 +82,5 @@ type ServicePackageSDKResource struct {
 	TypeName string
 	Name     string
 	Tags     *ServicePackageResourceTags
+	Identity *ServicePackageResourceIdentity
 }
@@ -9,6 +9,8 @@ import (
 	"fmt"
 	"log"
 	"reflect"
+	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/YakDriver/regexache"
@@ -16,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 )
 
 // SuppressEquivalentPolicyDiffs returns a difference suppression function that compares
@@ -213,6 +216,116 @@ func LegacyPolicyToSet(exist, new string) (string, error) {
 	return policyToSet, nil
 }
 
+// PolicyCanonicalize returns the canonical form of an IAM policy document, so that policies
+// that are equivalent according to awspolicyequivalence produce identical strings.
+// A single statement becomes a one-element list of statements and statements are sorted.
+// Actions, resources, principals and condition values become a string if there is exactly
+// one value and a sorted list otherwise. The Version element is placed first.
+func PolicyCanonicalize(policy string) (string, error) {
+	if strings.TrimSpace(policy) == "" {
+		return "", nil
+	}
+
+	var document map[string]any
+	if err := json.Unmarshal([]byte(policy), &document); err != nil {
+		return "", fmt.Errorf("policy (%s) is invalid JSON: %w", policy, err)
+	}
+
+	if v, ok := document["Statement"]; ok && v != nil {
+		statements, ok := v.([]any)
+		if !ok {
+			statements = []any{v}
+		}
+
+		canonical := make([]string, 0, len(statements))
+		for _, v := range statements {
+			statement, ok := v.(map[string]any)
+			if !ok {
+				return "", fmt.Errorf("policy statement (%v) is not a JSON object", v)
+			}
+
+			for k, v := range statement {
+				switch k {
+				case "Action", "NotAction", "Resource", "NotResource":
+					statement[k] = canonicalPolicyValues(v)
+				case "Principal", "NotPrincipal":
+					if m, ok := v.(map[string]any); ok {
+						for k, v := range m {
+							m[k] = canonicalPolicyValues(v)
+						}
+					}
+				case "Condition":
+					if m, ok := v.(map[string]any); ok {
+						for _, v := range m {
+							if m, ok := v.(map[string]any); ok {
+								for k, v := range m {
+									m[k] = canonicalPolicyValues(v)
+								}
+							}
+						}
+					}
+				}
+			}
+
+			b, err := json.Marshal(statement)
+			if err != nil {
+				return "", err
+			}
+			canonical = append(canonical, string(b))
+		}
+		slices.Sort(canonical)
+
+		document["Statement"] = tfslices.ApplyToAll(canonical, func(v string) json.RawMessage {
+			return json.RawMessage(v)
+		})
+	}
+
+	b, err := json.Marshal(document)
+	if err != nil {
+		return "", err
+	}
+
+	return LegacyPolicyNormalize(string(b))
+}
+
+// canonicalPolicyValues returns a single value as a string and multiple values as a sorted list of strings.
+// Boolean and number values are treated as their string representations.
+func canonicalPolicyValues(v any) any {
+	members, ok := v.([]any)
+	if !ok {
+		members = []any{v}
+	}
+
+	values := make([]string, 0, len(members))
+	for _, member := range members {
+		s, ok := canonicalPolicyValue(member)
+		if !ok {
+			return v
+		}
+		values = append(values, s)
+	}
+
+	if len(values) == 1 {
+		return values[0]
+	}
+	slices.Sort(values)
+
+	return values
+}
+
+func canonicalPolicyValue(v any) (string, bool) {
+	switch v := v.(type) {
+	case string:
+		return v, true
+	case bool:
+		return strconv.FormatBool(v), true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	default:
+		return "", false
+	}
+}
+
 // SuppressEquivalentJSONRemovingFieldsDiffs returns a difference suppression function that compares
 // two JSON strings and returns `true` if they are equivalent once the specified fields have been removed.
 func SuppressEquivalentJSONRemovingFieldsDiffs(fields ...string) schema.SchemaDiffSuppressFunc {
@@ -810,3 +810,101 @@ func TestLegacyPolicyNormalize(t *testing.T) {
 		})
 	}
 }
+
+func TestPolicyCanonicalize(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name     string
+		Input    string
+		Expected string
+		Error    bool
+	}{
+		{
+			Name:     "empty",
+			Input:    "",
+			Expected: "",
+		},
+		{
+			Name:     "singleStatement",
+			Input:    `{"Statement":{"Action":"s3:GetObject","Effect":"Allow","Resource":"*"},"Version":"2012-10-17"}`,
+			Expected: `{"Version":"2012-10-17","Statement":[{"Action":"s3:GetObject","Effect":"Allow","Resource":"*"}]}`,
+		},
+		{
+			Name:     "singleElementLists",
+			Input:    `{"Version":"2012-10-17","Statement":[{"Action":["s3:GetObject"],"Effect":"Allow","Resource":["*"]}]}`,
+			Expected: `{"Version":"2012-10-17","Statement":[{"Action":"s3:GetObject","Effect":"Allow","Resource":"*"}]}`,
+		},
+		{
+			Name: "unsortedLists",
+			Input: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": ["s3:PutObject", "s3:GetObject"],
+      "Resource": ["arn:aws:s3:::b/*", "arn:aws:s3:::a/*"]
+    }
+  ]
+}`,
+			Expected: `{"Version":"2012-10-17","Statement":[{"Action":["s3:GetObject","s3:PutObject"],"Effect":"Allow","Resource":["arn:aws:s3:::a/*","arn:aws:s3:::b/*"]}]}`,
+		},
+		{
+			Name:     "unsortedStatements",
+			Input:    `{"Version":"2012-10-17","Statement":[{"Action":"s3:PutObject","Effect":"Deny","Resource":"*"},{"Action":"s3:GetObject","Effect":"Allow","Resource":"*"}]}`,
+			Expected: `{"Version":"2012-10-17","Statement":[{"Action":"s3:GetObject","Effect":"Allow","Resource":"*"},{"Action":"s3:PutObject","Effect":"Deny","Resource":"*"}]}`,
+		},
+		{
+			Name:     "principals",
+			Input:    `{"Version":"2012-10-17","Statement":[{"Action":"sts:AssumeRole","Effect":"Allow","Principal":{"AWS":["arn:aws:iam::123456789012:root"],"Service":["lambda.amazonaws.com","ec2.amazonaws.com"]}}]}`,
+			Expected: `{"Version":"2012-10-17","Statement":[{"Action":"sts:AssumeRole","Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root","Service":["ec2.amazonaws.com","lambda.amazonaws.com"]}}]}`,
+		},
+		{
+			Name:     "wildcardPrincipal",
+			Input:    `{"Version":"2012-10-17","Statement":[{"Action":"s3:GetObject","Effect":"Allow","Principal":"*","Resource":"*"}]}`,
+			Expected: `{"Version":"2012-10-17","Statement":[{"Action":"s3:GetObject","Effect":"Allow","Principal":"*","Resource":"*"}]}`,
+		},
+		{
+			Name:     "conditions",
+			Input:    `{"Version":"2012-10-17","Statement":[{"Action":"s3:*","Condition":{"Bool":{"aws:SecureTransport":false},"StringEquals":{"aws:SourceVpc":["vpc-2","vpc-1"]}},"Effect":"Deny","Resource":"*"}]}`,
+			Expected: `{"Version":"2012-10-17","Statement":[{"Action":"s3:*","Condition":{"Bool":{"aws:SecureTransport":"false"},"StringEquals":{"aws:SourceVpc":["vpc-1","vpc-2"]}},"Effect":"Deny","Resource":"*"}]}`,
+		},
+		{
+			Name:  "badJSON",
+			Input: `{"Version":`,
+			Error: true,
+		},
+		{
+			Name:  "badStatement",
+			Input: `{"Version":"2012-10-17","Statement":["s3:GetObject"]}`,
+			Error: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+
+			p, err := PolicyCanonicalize(tc.Input)
+
+			if tc.Error {
+				if err == nil {
+					t.Errorf("expected an error")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("expected no error, got: %s", err)
+			}
+
+			if p != tc.Expected {
+				t.Errorf("expected %s, got: %s", tc.Expected, p)
+			}
+
+			if tc.Input != "" && !PolicyStringsEquivalent(tc.Input, p) {
+				t.Errorf("expected %s to be equivalent to %s", p, tc.Input)
+			}
+		})
+	}
+}
@@ -7,8 +7,10 @@ import (
 	"context"
 	"flag"
 	"log"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
 )
 
@@ -16,7 +18,7 @@ func main() {
 	debugFlag := flag.Bool("debug", false, "Start provider in debug mode.")
 	flag.Parse()
 
-	serverFactory, _, err := provider.ProtoV5ProviderServerFactory(context.Background())
+	serverFactory, primary, err := provider.ProtoV5ProviderServerFactory(context.Background())
 
 	if err != nil {
 		log.Fatal(err)
@@ -38,7 +40,23 @@ func main() {
 		serveOpts...,
 	)
 
+	// Serve returns once Terraform stops the provider.
+	if meta, ok := primary.Meta().(*conns.AWSClient); ok {
+		shutdownTracing(meta)
+	}
+
 	if err != nil {
 		log.Fatal(err)
 	}
 }
+
+// shutdownTracing exports any traces not yet exported before the provider exits.
+// Terraform kills the provider shortly after stopping it, so the time allowed is limited.
+func shutdownTracing(meta *conns.AWSClient) {
+	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
+	defer cancel()
+
+	if err := meta.ShutdownTracing(ctx); err != nil {
+		log.Printf("[WARN] shutting down tracing: %s", err)
+	}
+}
@@ -49,6 +49,7 @@ service "account" {
   provider_package_correct = "account"
   doc_prefix               = ["account_"]
   brand                    = "AWS"
+  is_global                = true
 }
 
 service "acm" {
@@ -1082,6 +1083,7 @@ service "ce" {
   provider_package_correct = "ce"
   doc_prefix               = ["ce_"]
   brand                    = "AWS"
+  is_global                = true
 }
 
 service "chatbot" {
@@ -1486,6 +1488,7 @@ service "cloudfront" {
   provider_package_correct = "cloudfront"
   doc_prefix               = ["cloudfront_"]
   brand                    = "AWS"
+  is_global                = true
 }
 
 service "cloudfrontkeyvaluestore" {
@@ -1522,6 +1525,7 @@ service "cloudfrontkeyvaluestore" {
   provider_package_correct = "cloudfrontkeyvaluestore"
   doc_prefix               = ["cloudfrontkeyvaluestore_"]
   brand                    = "AWS"
+  is_global                = true
 }
 
 service "cloudhsmv2" {
@@ -2623,6 +2627,7 @@ service "costoptimizationhub" {
   provider_package_correct = "costoptimizationhub"
   doc_prefix               = ["costoptimizationhub_"]
   brand                    = "AWS"
+  is_global                = true
 }
 
 service "cur" {
@@ -2659,6 +2664,7 @@ service "cur" {
   provider_package_correct = "cur"
   doc_prefix               = ["cur_"]
   brand                    = "AWS"
+  is_global                = true
 }
 
 service "dataexchange" {
@@ -4217,6 +4223,7 @@ service "globalaccelerator" {
   provider_package_correct = "globalaccelerator"
   doc_prefix               = ["globalaccelerator_"]
   brand                    = "AWS"
+  is_global                = true
 }
 
 service "glue" {
@@ -4436,6 +4443,7 @@ service "iam" {
   provider_package_correct = "iam"
   doc_prefix               = ["iam_"]
   brand                    = "AWS"
+  is_global                = true
 }
 
 service "inspector" {
@@ -6155,6 +6163,7 @@ service "meta" {
   provider_package_correct = "meta"
   doc_prefix               = ["arn", "ip_ranges", "billing_service_account", "default_tags", "partition", "region", "service\\."]
   brand                    = ""
+  is_global                = true
   exclude                  = true
   allowed_subcategory      = true
   note                     = "Not an AWS service (metadata)"
@@ -6512,6 +6521,7 @@ service "networkmanager" {
   provider_package_correct = "networkmanager"
   doc_prefix               = ["networkmanager_"]
   brand                    = "AWS"
+  is_global                = true
 }
 
 service "nimble" {
@@ -6745,6 +6755,7 @@ service "organizations" {
   provider_package_correct = "organizations"
   doc_prefix               = ["organizations_"]
   brand                    = "AWS"
+  is_global                = true
 }
 
 service "outposts" {
@@ -7114,6 +7125,7 @@ service "pricing" {
   provider_package_correct = "pricing"
   doc_prefix               = ["pricing_"]
   brand                    = "AWS"
+  is_global                = true
 }
 
 service "proton" {
@@ -7721,6 +7733,7 @@ service "route53" {
   provider_package_correct = "route53"
   doc_prefix               = ["route53_cidr_", "route53_delegation_", "route53_health_", "route53_hosted_", "route53_key_", "route53_query_", "route53_record", "route53_traffic_", "route53_vpc_", "route53_zone"]
   brand                    = "AWS"
+  is_global                = true
 }
 
 service "route53domains" {
@@ -7751,6 +7764,7 @@ service "route53domains" {
   provider_package_correct = "route53domains"
   doc_prefix               = ["route53domains_"]
   brand                    = "AWS"
+  is_global                = true
 }
 
 service "route53profiles" {
@@ -7842,6 +7856,7 @@ service "route53recoverycontrolconfig" {
   provider_package_correct = "route53recoverycontrolconfig"
   doc_prefix               = ["route53recoverycontrolconfig_"]
   brand                    = "AWS"
+  is_global                = true
 }
 
 service "route53recoveryreadiness" {
@@ -7877,6 +7892,7 @@ service "route53recoveryreadiness" {
   provider_package_correct = "route53recoveryreadiness"
   doc_prefix               = ["route53recoveryreadiness_"]
   brand                    = "AWS"
+  is_global                = true
 }
 
 service "route53resolver" {
@@ -8608,6 +8624,7 @@ service "shield" {
   provider_package_correct = "shield"
   doc_prefix               = ["shield_"]
   brand                    = "AWS"
+  is_global                = true
 }
 
 service "signer" {
@@ -9429,6 +9446,7 @@ service "waf" {
   provider_package_correct = "waf"
   doc_prefix               = ["waf_"]
   brand                    = "AWS"
+  is_global                = true
 }
 
 service "wafregional" {
@@ -9485,6 +9503,7 @@ service "budgets" {
   provider_package_correct = "budgets"
   doc_prefix               = ["budgets_"]
   brand                    = "AWS"
+  is_global                = true
 }
 
 service "wellarchitected" {
@@ -139,6 +139,10 @@ func (sr ServiceRecord) EndpointOnly() bool {
 	return sr[colEndpointOnly] != ""
 }
 
+func (sr ServiceRecord) IsGlobal() bool {
+	return sr[colIsGlobal] != ""
+}
+
 func (sr ServiceRecord) AllowedSubcategory() string {
 	return sr[colAllowedSubcategory]
 }
@@ -278,6 +282,11 @@ func parseService(curr Service) ServiceRecord {
 	} else {
 		record[colAllowedSubcategory] = ""
 	}
+	if curr.IsGlobal {
+		record[colIsGlobal] = "x"
+	} else {
+		record[colIsGlobal] = ""
+	}
 	record[colNote] = curr.Note
 	if len(curr.ServiceProviderPackageCorrect) > 0 {
 		record[colProviderPackageCorrect] = curr.ServiceProviderPackageCorrect
@@ -378,6 +387,7 @@ type Service struct {
 	Exclude                       bool     `hcl:"exclude,optional"`
 	NotImplemented                bool     `hcl:"not_implemented,optional"`
 	AllowedSubcategory            bool     `hcl:"allowed_subcategory,optional"`
+	IsGlobal                      bool     `hcl:"is_global,optional"`
 	Note                          string   `hcl:"note,optional"`
 }
 
@@ -418,5 +428,6 @@ const (
 	colEndpointAPICall   // API call to use for endpoint tests
 	colEndpointAPIParams // Any needed parameters for endpoint tests
 	colEndpointOverrideRegion
+	colIsGlobal // If set, the service's resources are global rather than regional
 	colNote
 )
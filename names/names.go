@@ -278,6 +278,26 @@ func PartitionForRegion(region string) string {
 	}
 }
 
+// ServicePrincipalNameForPartition returns the IAM service principal for the specified
+// service (e.g. "lambda") in the specified partition.
+func ServicePrincipalNameForPartition(service, partition string) string {
+	if service == "" || partition == "" {
+		return ""
+	}
+
+	switch partition {
+	case ISOPartitionID, ISOBPartitionID, ISOEPartitionID, ISOFPartitionID:
+		return fmt.Sprintf("%s.%s", service, DNSSuffixForPartition(partition))
+	case ChinaPartitionID:
+		// Only a handful of service principals use the China DNS suffix.
+		if service == "ec2" {
+			return fmt.Sprintf("%s.%s", service, DNSSuffixForPartition(partition))
+		}
+	}
+
+	return fmt.Sprintf("%s.%s", service, DNSSuffixForPartition(StandardPartitionID))
+}
+
 // ReverseDNS switches a DNS hostname to reverse DNS and vice-versa.
 func ReverseDNS(hostname string) string {
 	parts := strings.Split(hostname, ".")
@@ -296,12 +316,14 @@ type ServiceDatum struct {
 	AWSServiceEnvVar   string
 	Brand              string
 	ClientSDKV1        bool
+	ClientSDKV2        bool
 	DeprecatedEnvVar   string
 	EndpointOnly       bool
 	GoV1ClientTypeName string
 	GoV1Package        string
 	GoV2Package        string
 	HumanFriendly      string
+	IsGlobal           bool
 	ProviderNameUpper  string
 	SDKID              string
 	TFAWSEnvVar        string
@@ -343,12 +365,14 @@ func readCSVIntoServiceData() error {
 			AWSServiceEnvVar:   l.AWSServiceEnvVar(),
 			Brand:              l.Brand(),
 			ClientSDKV1:        l.ClientSDKV1(),
+			ClientSDKV2:        l.ClientSDKV2(),
 			DeprecatedEnvVar:   l.DeprecatedEnvVar(),
 			EndpointOnly:       l.EndpointOnly(),
 			GoV1ClientTypeName: l.GoV1ClientTypeName(),
 			GoV1Package:        l.GoV1Package(),
 			GoV2Package:        l.GoV2Package(),
 			HumanFriendly:      l.HumanFriendly(),
+			IsGlobal:           l.IsGlobal(),
 			ProviderNameUpper:  l.ProviderNameUpper(),
 			SDKID:              l.SDKID(),
 			TFAWSEnvVar:        l.TFAWSEnvVar(),
@@ -494,6 +518,23 @@ func ClientSDKV1(service string) bool {
 	return false
 }
 
+func ClientSDKV2(service string) bool {
+	if v, ok := serviceData[service]; ok {
+		return v.ClientSDKV2
+	}
+
+	return false
+}
+
+// IsGlobal returns whether the specified service's resources are global rather than regional.
+func IsGlobal(service string) bool {
+	if v, ok := serviceData[service]; ok {
+		return v.IsGlobal
+	}
+
+	return false
+}
+
 func FullHumanFriendly(service string) (string, error) {
 	if v, ok := serviceData[service]; ok {
 		if v.Brand == "" {
@@ -152,6 +152,71 @@ func TestPartitionForRegion(t *testing.T) {
 	}
 }
 
+func TestServicePrincipalNameForPartition(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		service   string
+		partition string
+		expected  string
+	}{
+		{
+			name:      "empty service",
+			service:   "",
+			partition: StandardPartitionID,
+			expected:  "",
+		},
+		{
+			name:      "empty partition",
+			service:   "lambda",
+			partition: "",
+			expected:  "",
+		},
+		{
+			name:      "standard",
+			service:   "lambda",
+			partition: StandardPartitionID,
+			expected:  "lambda.amazonaws.com",
+		},
+		{
+			name:      "GovCloud",
+			service:   "lambda",
+			partition: USGovCloudPartitionID,
+			expected:  "lambda.amazonaws.com",
+		},
+		{
+			name:      "China",
+			service:   "lambda",
+			partition: ChinaPartitionID,
+			expected:  "lambda.amazonaws.com",
+		},
+		{
+			name:      "China EC2",
+			service:   "ec2",
+			partition: ChinaPartitionID,
+			expected:  "ec2.amazonaws.com.cn",
+		},
+		{
+			name:      "ISO",
+			service:   "lambda",
+			partition: ISOPartitionID,
+			expected:  "lambda.c2s.ic.gov",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := ServicePrincipalNameForPartition(testCase.service, testCase.partition), testCase.expected; got != want {
+				t.Errorf("got: %s, expected: %s", got, want)
+			}
+		})
+	}
+}
+
 func TestReverseDNS(t *testing.T) {
 	t.Parallel()
 
@@ -470,6 +535,53 @@ func TestProviderNameUpper(t *testing.T) {
 	}
 }
 
+func TestIsGlobal(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName string
+		Input    string
+		Expected bool
+	}{
+		{
+			TestName: "empty",
+			Input:    "",
+			Expected: false,
+		},
+		{
+			TestName: IAM,
+			Input:    IAM,
+			Expected: true,
+		},
+		{
+			TestName: Route53,
+			Input:    Route53,
+			Expected: true,
+		},
+		{
+			TestName: Transcribe,
+			Input:    Transcribe,
+			Expected: false,
+		},
+		{
+			TestName: "doesnotexist",
+			Input:    "doesnotexist",
+			Expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			if got := IsGlobal(testCase.Input); got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
 func TestFullHumanFriendly(t *testing.T) {
 	t.Parallel()
 